@@ -11,19 +11,33 @@ var jspb = require('google-protobuf');
 var goog = jspb;
 var global = Function('return this')();
 
+goog.exportSymbol('proto.firestarter.AuditEvent', null, global);
+goog.exportSymbol('proto.firestarter.AuditEventList', null, global);
 goog.exportSymbol('proto.firestarter.Channels', null, global);
 goog.exportSymbol('proto.firestarter.Config', null, global);
 goog.exportSymbol('proto.firestarter.ConfigList', null, global);
 goog.exportSymbol('proto.firestarter.DeleteConfigRequest', null, global);
 goog.exportSymbol('proto.firestarter.DeleteConfigResponse', null, global);
 goog.exportSymbol('proto.firestarter.DumpConfigListRequest', null, global);
+goog.exportSymbol('proto.firestarter.Execution', null, global);
+goog.exportSymbol('proto.firestarter.ExecutionList', null, global);
 goog.exportSymbol('proto.firestarter.GetChannelsRequest', null, global);
 goog.exportSymbol('proto.firestarter.GetConfigListRequest', null, global);
 goog.exportSymbol('proto.firestarter.GetConfigRequest', null, global);
+goog.exportSymbol('proto.firestarter.GetExecutionRequest', null, global);
+goog.exportSymbol('proto.firestarter.GetExecutionsRequest', null, global);
+goog.exportSymbol('proto.firestarter.GetMembersRequest', null, global);
+goog.exportSymbol('proto.firestarter.Header', null, global);
+goog.exportSymbol('proto.firestarter.ListAuditEventsRequest', null, global);
+goog.exportSymbol('proto.firestarter.Member', null, global);
+goog.exportSymbol('proto.firestarter.Members', null, global);
 goog.exportSymbol('proto.firestarter.RestoreConfigListRequest', null, global);
 goog.exportSymbol('proto.firestarter.RestoreConfigListResponse', null, global);
 goog.exportSymbol('proto.firestarter.Secret', null, global);
 goog.exportSymbol('proto.firestarter.SetConfigResponse', null, global);
+goog.exportSymbol('proto.firestarter.Step', null, global);
+goog.exportSymbol('proto.firestarter.TestConfigRequest', null, global);
+goog.exportSymbol('proto.firestarter.TestConfigResponse', null, global);
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -846,7 +860,8 @@ proto.firestarter.RestoreConfigListRequest.prototype.toObject = function(opt_inc
 proto.firestarter.RestoreConfigListRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     password: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    configlist: (f = msg.getConfiglist()) && proto.firestarter.ConfigList.toObject(includeInstance, f)
+    configlist: (f = msg.getConfiglist()) && proto.firestarter.ConfigList.toObject(includeInstance, f),
+    merge: jspb.Message.getFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -892,6 +907,10 @@ proto.firestarter.RestoreConfigListRequest.deserializeBinaryFromReader = functio
       reader.readMessage(value,proto.firestarter.ConfigList.deserializeBinaryFromReader);
       msg.setConfiglist(value);
       break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMerge(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -936,6 +955,13 @@ proto.firestarter.RestoreConfigListRequest.serializeBinaryToWriter = function(me
       proto.firestarter.ConfigList.serializeBinaryToWriter
     );
   }
+  f = message.getMerge();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -984,6 +1010,23 @@ proto.firestarter.RestoreConfigListRequest.prototype.hasConfiglist = function()
 };
 
 
+/**
+ * optional bool merge = 3;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.firestarter.RestoreConfigListRequest.prototype.getMerge = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 3, false));
+};
+
+
+/** @param {boolean} value */
+proto.firestarter.RestoreConfigListRequest.prototype.setMerge = function(value) {
+  jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -1111,12 +1154,12 @@ proto.firestarter.RestoreConfigListResponse.serializeBinaryToWriter = function(m
  * @extends {jspb.Message}
  * @constructor
  */
-proto.firestarter.Secret = function(opt_data) {
+proto.firestarter.ListAuditEventsRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.firestarter.Secret, jspb.Message);
+goog.inherits(proto.firestarter.ListAuditEventsRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.firestarter.Secret.displayName = 'proto.firestarter.Secret';
+  proto.firestarter.ListAuditEventsRequest.displayName = 'proto.firestarter.ListAuditEventsRequest';
 }
 
 
@@ -1131,8 +1174,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.firestarter.Secret.prototype.toObject = function(opt_includeInstance) {
-  return proto.firestarter.Secret.toObject(opt_includeInstance, this);
+proto.firestarter.ListAuditEventsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.ListAuditEventsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1141,14 +1184,15 @@ proto.firestarter.Secret.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.firestarter.Secret} msg The msg instance to transform.
+ * @param {!proto.firestarter.ListAuditEventsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.firestarter.Secret.toObject = function(includeInstance, msg) {
+proto.firestarter.ListAuditEventsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    key: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    value: jspb.Message.getFieldWithDefault(msg, 2, "")
+    configid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    since: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    until: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -1162,23 +1206,23 @@ proto.firestarter.Secret.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.firestarter.Secret}
+ * @return {!proto.firestarter.ListAuditEventsRequest}
  */
-proto.firestarter.Secret.deserializeBinary = function(bytes) {
+proto.firestarter.ListAuditEventsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.firestarter.Secret;
-  return proto.firestarter.Secret.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.firestarter.ListAuditEventsRequest;
+  return proto.firestarter.ListAuditEventsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.firestarter.Secret} msg The message object to deserialize into.
+ * @param {!proto.firestarter.ListAuditEventsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.firestarter.Secret}
+ * @return {!proto.firestarter.ListAuditEventsRequest}
  */
-proto.firestarter.Secret.deserializeBinaryFromReader = function(msg, reader) {
+proto.firestarter.ListAuditEventsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1187,11 +1231,15 @@ proto.firestarter.Secret.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setKey(value);
+      msg.setConfigid(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setValue(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSince(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUntil(value);
       break;
     default:
       reader.skipField();
@@ -1206,9 +1254,9 @@ proto.firestarter.Secret.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.firestarter.Secret.prototype.serializeBinary = function() {
+proto.firestarter.ListAuditEventsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.firestarter.Secret.serializeBinaryToWriter(this, writer);
+  proto.firestarter.ListAuditEventsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1216,56 +1264,78 @@ proto.firestarter.Secret.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.firestarter.Secret} message
+ * @param {!proto.firestarter.ListAuditEventsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.firestarter.Secret.serializeBinaryToWriter = function(message, writer) {
+proto.firestarter.ListAuditEventsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getKey();
+  f = message.getConfigid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getValue();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSince();
+  if (f !== 0) {
+    writer.writeInt64(
       2,
       f
     );
   }
+  f = message.getUntil();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string Key = 1;
+ * optional string ConfigID = 1;
  * @return {string}
  */
-proto.firestarter.Secret.prototype.getKey = function() {
+proto.firestarter.ListAuditEventsRequest.prototype.getConfigid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.firestarter.Secret.prototype.setKey = function(value) {
+proto.firestarter.ListAuditEventsRequest.prototype.setConfigid = function(value) {
   jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string Value = 2;
- * @return {string}
+ * optional int64 Since = 2;
+ * @return {number}
  */
-proto.firestarter.Secret.prototype.getValue = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.firestarter.ListAuditEventsRequest.prototype.getSince = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
-/** @param {string} value */
-proto.firestarter.Secret.prototype.setValue = function(value) {
-  jspb.Message.setProto3StringField(this, 2, value);
+/** @param {number} value */
+proto.firestarter.ListAuditEventsRequest.prototype.setSince = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 Until = 3;
+ * @return {number}
+ */
+proto.firestarter.ListAuditEventsRequest.prototype.getUntil = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.firestarter.ListAuditEventsRequest.prototype.setUntil = function(value) {
+  jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
@@ -1280,19 +1350,19 @@ proto.firestarter.Secret.prototype.setValue = function(value) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.firestarter.Config = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.Config.repeatedFields_, null);
+proto.firestarter.AuditEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.AuditEvent.repeatedFields_, null);
 };
-goog.inherits(proto.firestarter.Config, jspb.Message);
+goog.inherits(proto.firestarter.AuditEvent, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.firestarter.Config.displayName = 'proto.firestarter.Config';
+  proto.firestarter.AuditEvent.displayName = 'proto.firestarter.AuditEvent';
 }
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.firestarter.Config.repeatedFields_ = [3,9,10];
+proto.firestarter.AuditEvent.repeatedFields_ = [6];
 
 
 
@@ -1307,8 +1377,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.firestarter.Config.prototype.toObject = function(opt_includeInstance) {
-  return proto.firestarter.Config.toObject(opt_includeInstance, this);
+proto.firestarter.AuditEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.AuditEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -1317,23 +1387,20 @@ proto.firestarter.Config.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.firestarter.Config} msg The msg instance to transform.
+ * @param {!proto.firestarter.AuditEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.firestarter.Config.toObject = function(includeInstance, msg) {
+proto.firestarter.AuditEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    title: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    id: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    channelsList: jspb.Message.getRepeatedField(msg, 3),
-    texttemplate: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    regexp: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    urltemplate: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    bodytemplate: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    confirm: jspb.Message.getFieldWithDefault(msg, 8, false),
-    actionsList: jspb.Message.getRepeatedField(msg, 9),
-    secretsList: jspb.Message.toObjectList(msg.getSecretsList(),
-    proto.firestarter.Secret.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    time: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    actor: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    action: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    configid: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    diffList: jspb.Message.getRepeatedField(msg, 6),
+    detail: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    outcome: jspb.Message.getFieldWithDefault(msg, 8, "")
   };
 
   if (includeInstance) {
@@ -1347,23 +1414,23 @@ proto.firestarter.Config.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.firestarter.Config}
+ * @return {!proto.firestarter.AuditEvent}
  */
-proto.firestarter.Config.deserializeBinary = function(bytes) {
+proto.firestarter.AuditEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.firestarter.Config;
-  return proto.firestarter.Config.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.firestarter.AuditEvent;
+  return proto.firestarter.AuditEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.firestarter.Config} msg The message object to deserialize into.
+ * @param {!proto.firestarter.AuditEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.firestarter.Config}
+ * @return {!proto.firestarter.AuditEvent}
  */
-proto.firestarter.Config.deserializeBinaryFromReader = function(msg, reader) {
+proto.firestarter.AuditEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1372,44 +1439,35 @@ proto.firestarter.Config.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTitle(value);
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTime(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.addChannels(value);
+      msg.setActor(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTexttemplate(value);
+      msg.setAction(value);
       break;
     case 5:
       var value = /** @type {string} */ (reader.readString());
-      msg.setRegexp(value);
+      msg.setConfigid(value);
       break;
     case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrltemplate(value);
+      msg.addDiff(value);
       break;
     case 7:
       var value = /** @type {string} */ (reader.readString());
-      msg.setBodytemplate(value);
+      msg.setDetail(value);
       break;
     case 8:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setConfirm(value);
-      break;
-    case 9:
       var value = /** @type {string} */ (reader.readString());
-      msg.addActions(value);
-      break;
-    case 10:
-      var value = new proto.firestarter.Secret;
-      reader.readMessage(value,proto.firestarter.Secret.deserializeBinaryFromReader);
-      msg.addSecrets(value);
+      msg.setOutcome(value);
       break;
     default:
       reader.skipField();
@@ -1424,9 +1482,9 @@ proto.firestarter.Config.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.firestarter.Config.prototype.serializeBinary = function() {
+proto.firestarter.AuditEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.firestarter.Config.serializeBinaryToWriter(this, writer);
+  proto.firestarter.AuditEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1434,279 +1492,4362 @@ proto.firestarter.Config.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.firestarter.Config} message
+ * @param {!proto.firestarter.AuditEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.firestarter.Config.serializeBinaryToWriter = function(message, writer) {
+proto.firestarter.AuditEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTitle();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getTime();
+  if (f !== 0) {
+    writer.writeInt64(
       2,
       f
     );
   }
-  f = message.getChannelsList();
+  f = message.getActor();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       3,
       f
     );
   }
-  f = message.getTexttemplate();
+  f = message.getAction();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
-  f = message.getRegexp();
+  f = message.getConfigid();
   if (f.length > 0) {
     writer.writeString(
       5,
       f
     );
   }
-  f = message.getUrltemplate();
+  f = message.getDiffList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       6,
       f
     );
   }
-  f = message.getBodytemplate();
+  f = message.getDetail();
   if (f.length > 0) {
     writer.writeString(
       7,
       f
     );
   }
-  f = message.getConfirm();
-  if (f) {
-    writer.writeBool(
-      8,
-      f
-    );
-  }
-  f = message.getActionsList();
+  f = message.getOutcome();
   if (f.length > 0) {
-    writer.writeRepeatedString(
-      9,
+    writer.writeString(
+      8,
       f
     );
   }
-  f = message.getSecretsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      10,
-      f,
-      proto.firestarter.Secret.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string Title = 1;
+ * optional string ID = 1;
  * @return {string}
  */
-proto.firestarter.Config.prototype.getTitle = function() {
+proto.firestarter.AuditEvent.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.firestarter.Config.prototype.setTitle = function(value) {
+proto.firestarter.AuditEvent.prototype.setId = function(value) {
   jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string ID = 2;
- * @return {string}
- */
-proto.firestarter.Config.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/** @param {string} value */
-proto.firestarter.Config.prototype.setId = function(value) {
-  jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * repeated string Channels = 3;
- * @return {!Array.<string>}
+ * optional int64 Time = 2;
+ * @return {number}
  */
-proto.firestarter.Config.prototype.getChannelsList = function() {
-  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.firestarter.AuditEvent.prototype.getTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
-/** @param {!Array.<string>} value */
-proto.firestarter.Config.prototype.setChannelsList = function(value) {
-  jspb.Message.setField(this, 3, value || []);
+/** @param {number} value */
+proto.firestarter.AuditEvent.prototype.setTime = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * @param {!string} value
- * @param {number=} opt_index
+ * optional string Actor = 3;
+ * @return {string}
  */
-proto.firestarter.Config.prototype.addChannels = function(value, opt_index) {
-  jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.firestarter.AuditEvent.prototype.getActor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
-proto.firestarter.Config.prototype.clearChannelsList = function() {
-  this.setChannelsList([]);
+/** @param {string} value */
+proto.firestarter.AuditEvent.prototype.setActor = function(value) {
+  jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string TextTemplate = 4;
+ * optional string Action = 4;
  * @return {string}
  */
-proto.firestarter.Config.prototype.getTexttemplate = function() {
+proto.firestarter.AuditEvent.prototype.getAction = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /** @param {string} value */
-proto.firestarter.Config.prototype.setTexttemplate = function(value) {
+proto.firestarter.AuditEvent.prototype.setAction = function(value) {
   jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional string Regexp = 5;
+ * optional string ConfigID = 5;
  * @return {string}
  */
-proto.firestarter.Config.prototype.getRegexp = function() {
+proto.firestarter.AuditEvent.prototype.getConfigid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /** @param {string} value */
-proto.firestarter.Config.prototype.setRegexp = function(value) {
+proto.firestarter.AuditEvent.prototype.setConfigid = function(value) {
   jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * optional string URLTemplate = 6;
- * @return {string}
+ * repeated string Diff = 6;
+ * @return {!Array.<string>}
  */
-proto.firestarter.Config.prototype.getUrltemplate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.firestarter.AuditEvent.prototype.getDiffList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 6));
 };
 
 
-/** @param {string} value */
-proto.firestarter.Config.prototype.setUrltemplate = function(value) {
-  jspb.Message.setProto3StringField(this, 6, value);
+/** @param {!Array.<string>} value */
+proto.firestarter.AuditEvent.prototype.setDiffList = function(value) {
+  jspb.Message.setField(this, 6, value || []);
 };
 
 
 /**
- * optional string BodyTemplate = 7;
- * @return {string}
+ * @param {!string} value
+ * @param {number=} opt_index
  */
-proto.firestarter.Config.prototype.getBodytemplate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.firestarter.AuditEvent.prototype.addDiff = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 6, value, opt_index);
 };
 
 
-/** @param {string} value */
-proto.firestarter.Config.prototype.setBodytemplate = function(value) {
-  jspb.Message.setProto3StringField(this, 7, value);
+proto.firestarter.AuditEvent.prototype.clearDiffList = function() {
+  this.setDiffList([]);
 };
 
 
 /**
- * optional bool Confirm = 8;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
+ * optional string Detail = 7;
+ * @return {string}
  */
-proto.firestarter.Config.prototype.getConfirm = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 8, false));
+proto.firestarter.AuditEvent.prototype.getDetail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
-/** @param {boolean} value */
-proto.firestarter.Config.prototype.setConfirm = function(value) {
-  jspb.Message.setProto3BooleanField(this, 8, value);
+/** @param {string} value */
+proto.firestarter.AuditEvent.prototype.setDetail = function(value) {
+  jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
 /**
- * repeated string Actions = 9;
- * @return {!Array.<string>}
+ * optional string Outcome = 8;
+ * @return {string}
  */
-proto.firestarter.Config.prototype.getActionsList = function() {
-  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 9));
+proto.firestarter.AuditEvent.prototype.getOutcome = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
-/** @param {!Array.<string>} value */
-proto.firestarter.Config.prototype.setActionsList = function(value) {
-  jspb.Message.setField(this, 9, value || []);
+/** @param {string} value */
+proto.firestarter.AuditEvent.prototype.setOutcome = function(value) {
+  jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
+
 /**
- * @param {!string} value
- * @param {number=} opt_index
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.firestarter.Config.prototype.addActions = function(value, opt_index) {
-  jspb.Message.addToRepeatedField(this, 9, value, opt_index);
+proto.firestarter.AuditEventList = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.AuditEventList.repeatedFields_, null);
 };
+goog.inherits(proto.firestarter.AuditEventList, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.AuditEventList.displayName = 'proto.firestarter.AuditEventList';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.firestarter.AuditEventList.repeatedFields_ = [1];
 
 
-proto.firestarter.Config.prototype.clearActionsList = function() {
-  this.setActionsList([]);
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.AuditEventList.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.AuditEventList.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.AuditEventList} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.AuditEventList.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    eventsList: jspb.Message.toObjectList(msg.getEventsList(),
+    proto.firestarter.AuditEvent.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.AuditEventList}
+ */
+proto.firestarter.AuditEventList.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.AuditEventList;
+  return proto.firestarter.AuditEventList.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.AuditEventList} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.AuditEventList}
+ */
+proto.firestarter.AuditEventList.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.firestarter.AuditEvent;
+      reader.readMessage(value,proto.firestarter.AuditEvent.deserializeBinaryFromReader);
+      msg.addEvents(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.AuditEventList.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.AuditEventList.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.AuditEventList} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.AuditEventList.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEventsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.firestarter.AuditEvent.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated AuditEvent Events = 1;
+ * @return {!Array.<!proto.firestarter.AuditEvent>}
+ */
+proto.firestarter.AuditEventList.prototype.getEventsList = function() {
+  return /** @type{!Array.<!proto.firestarter.AuditEvent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.firestarter.AuditEvent, 1));
+};
+
+
+/** @param {!Array.<!proto.firestarter.AuditEvent>} value */
+proto.firestarter.AuditEventList.prototype.setEventsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.firestarter.AuditEvent=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.firestarter.AuditEvent}
+ */
+proto.firestarter.AuditEventList.prototype.addEvents = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.firestarter.AuditEvent, opt_index);
+};
+
+
+proto.firestarter.AuditEventList.prototype.clearEventsList = function() {
+  this.setEventsList([]);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.GetExecutionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.firestarter.GetExecutionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.GetExecutionsRequest.displayName = 'proto.firestarter.GetExecutionsRequest';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.GetExecutionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.GetExecutionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.GetExecutionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.GetExecutionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    configid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pagesize: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    pagetoken: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.GetExecutionsRequest}
+ */
+proto.firestarter.GetExecutionsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.GetExecutionsRequest;
+  return proto.firestarter.GetExecutionsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.GetExecutionsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.GetExecutionsRequest}
+ */
+proto.firestarter.GetExecutionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setConfigid(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPagesize(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPagetoken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.GetExecutionsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.GetExecutionsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.GetExecutionsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.GetExecutionsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getConfigid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPagesize();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getPagetoken();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string ConfigID = 1;
+ * @return {string}
+ */
+proto.firestarter.GetExecutionsRequest.prototype.getConfigid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.GetExecutionsRequest.prototype.setConfigid = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 PageSize = 2;
+ * @return {number}
+ */
+proto.firestarter.GetExecutionsRequest.prototype.getPagesize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.firestarter.GetExecutionsRequest.prototype.setPagesize = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string PageToken = 3;
+ * @return {string}
+ */
+proto.firestarter.GetExecutionsRequest.prototype.getPagetoken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.GetExecutionsRequest.prototype.setPagetoken = function(value) {
+  jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.GetExecutionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.firestarter.GetExecutionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.GetExecutionRequest.displayName = 'proto.firestarter.GetExecutionRequest';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.GetExecutionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.GetExecutionRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.GetExecutionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.GetExecutionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.GetExecutionRequest}
+ */
+proto.firestarter.GetExecutionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.GetExecutionRequest;
+  return proto.firestarter.GetExecutionRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.GetExecutionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.GetExecutionRequest}
+ */
+proto.firestarter.GetExecutionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.GetExecutionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.GetExecutionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.GetExecutionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.GetExecutionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string ID = 1;
+ * @return {string}
+ */
+proto.firestarter.GetExecutionRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.GetExecutionRequest.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.Execution = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.firestarter.Execution, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.Execution.displayName = 'proto.firestarter.Execution';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.Execution.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.Execution.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.Execution} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Execution.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    time: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    sessionid: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    configid: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    user: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    channel: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    method: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    url: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    body: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    statuscode: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    latencyms: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    error: jspb.Message.getFieldWithDefault(msg, 12, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.Execution}
+ */
+proto.firestarter.Execution.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.Execution;
+  return proto.firestarter.Execution.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.Execution} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.Execution}
+ */
+proto.firestarter.Execution.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTime(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSessionid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setConfigid(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUser(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChannel(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMethod(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBody(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setStatuscode(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLatencyms(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.Execution.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.Execution.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.Execution} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Execution.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTime();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getSessionid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getConfigid();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getUser();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getChannel();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getMethod();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getBody();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getStatuscode();
+  if (f !== 0) {
+    writer.writeInt32(
+      10,
+      f
+    );
+  }
+  f = message.getLatencyms();
+  if (f !== 0) {
+    writer.writeInt64(
+      11,
+      f
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string ID = 1;
+ * @return {string}
+ */
+proto.firestarter.Execution.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Execution.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 Time = 2;
+ * @return {number}
+ */
+proto.firestarter.Execution.prototype.getTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.firestarter.Execution.prototype.setTime = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string SessionID = 3;
+ * @return {string}
+ */
+proto.firestarter.Execution.prototype.getSessionid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Execution.prototype.setSessionid = function(value) {
+  jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string ConfigID = 4;
+ * @return {string}
+ */
+proto.firestarter.Execution.prototype.getConfigid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Execution.prototype.setConfigid = function(value) {
+  jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string User = 5;
+ * @return {string}
+ */
+proto.firestarter.Execution.prototype.getUser = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Execution.prototype.setUser = function(value) {
+  jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string Channel = 6;
+ * @return {string}
+ */
+proto.firestarter.Execution.prototype.getChannel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Execution.prototype.setChannel = function(value) {
+  jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string Method = 7;
+ * @return {string}
+ */
+proto.firestarter.Execution.prototype.getMethod = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Execution.prototype.setMethod = function(value) {
+  jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string URL = 8;
+ * @return {string}
+ */
+proto.firestarter.Execution.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Execution.prototype.setUrl = function(value) {
+  jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional string Body = 9;
+ * @return {string}
+ */
+proto.firestarter.Execution.prototype.getBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Execution.prototype.setBody = function(value) {
+  jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional int32 StatusCode = 10;
+ * @return {number}
+ */
+proto.firestarter.Execution.prototype.getStatuscode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/** @param {number} value */
+proto.firestarter.Execution.prototype.setStatuscode = function(value) {
+  jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional int64 LatencyMs = 11;
+ * @return {number}
+ */
+proto.firestarter.Execution.prototype.getLatencyms = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/** @param {number} value */
+proto.firestarter.Execution.prototype.setLatencyms = function(value) {
+  jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * optional string Error = 12;
+ * @return {string}
+ */
+proto.firestarter.Execution.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Execution.prototype.setError = function(value) {
+  jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.ExecutionList = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.ExecutionList.repeatedFields_, null);
+};
+goog.inherits(proto.firestarter.ExecutionList, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.ExecutionList.displayName = 'proto.firestarter.ExecutionList';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.firestarter.ExecutionList.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.ExecutionList.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.ExecutionList.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.ExecutionList} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.ExecutionList.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    executionsList: jspb.Message.toObjectList(msg.getExecutionsList(),
+    proto.firestarter.Execution.toObject, includeInstance),
+    nextpagetoken: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.ExecutionList}
+ */
+proto.firestarter.ExecutionList.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.ExecutionList;
+  return proto.firestarter.ExecutionList.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.ExecutionList} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.ExecutionList}
+ */
+proto.firestarter.ExecutionList.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.firestarter.Execution;
+      reader.readMessage(value,proto.firestarter.Execution.deserializeBinaryFromReader);
+      msg.addExecutions(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextpagetoken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.ExecutionList.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.ExecutionList.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.ExecutionList} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.ExecutionList.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExecutionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.firestarter.Execution.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextpagetoken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated Execution Executions = 1;
+ * @return {!Array.<!proto.firestarter.Execution>}
+ */
+proto.firestarter.ExecutionList.prototype.getExecutionsList = function() {
+  return /** @type{!Array.<!proto.firestarter.Execution>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.firestarter.Execution, 1));
+};
+
+
+/** @param {!Array.<!proto.firestarter.Execution>} value */
+proto.firestarter.ExecutionList.prototype.setExecutionsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.firestarter.Execution=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.firestarter.Execution}
+ */
+proto.firestarter.ExecutionList.prototype.addExecutions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.firestarter.Execution, opt_index);
+};
+
+
+proto.firestarter.ExecutionList.prototype.clearExecutionsList = function() {
+  this.setExecutionsList([]);
+};
+
+
+/**
+ * optional string NextPageToken = 2;
+ * @return {string}
+ */
+proto.firestarter.ExecutionList.prototype.getNextpagetoken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.ExecutionList.prototype.setNextpagetoken = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.TestConfigRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.firestarter.TestConfigRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.TestConfigRequest.displayName = 'proto.firestarter.TestConfigRequest';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.TestConfigRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.TestConfigRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.TestConfigRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.TestConfigRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    config: (f = msg.getConfig()) && proto.firestarter.Config.toObject(includeInstance, f),
+    message: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    channel: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    send: jspb.Message.getFieldWithDefault(msg, 5, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.TestConfigRequest}
+ */
+proto.firestarter.TestConfigRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.TestConfigRequest;
+  return proto.firestarter.TestConfigRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.TestConfigRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.TestConfigRequest}
+ */
+proto.firestarter.TestConfigRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.firestarter.Config;
+      reader.readMessage(value,proto.firestarter.Config.deserializeBinaryFromReader);
+      msg.setConfig(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChannel(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSend(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.TestConfigRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.TestConfigRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.TestConfigRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.TestConfigRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getConfig();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.firestarter.Config.serializeBinaryToWriter
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getChannel();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getSend();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional Config Config = 1;
+ * @return {?proto.firestarter.Config}
+ */
+proto.firestarter.TestConfigRequest.prototype.getConfig = function() {
+  return /** @type{?proto.firestarter.Config} */ (
+    jspb.Message.getWrapperField(this, proto.firestarter.Config, 1));
+};
+
+
+/** @param {?proto.firestarter.Config|undefined} value */
+proto.firestarter.TestConfigRequest.prototype.setConfig = function(value) {
+  jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+proto.firestarter.TestConfigRequest.prototype.clearConfig = function() {
+  this.setConfig(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.firestarter.TestConfigRequest.prototype.hasConfig = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string Message = 2;
+ * @return {string}
+ */
+proto.firestarter.TestConfigRequest.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.TestConfigRequest.prototype.setMessage = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string Channel = 3;
+ * @return {string}
+ */
+proto.firestarter.TestConfigRequest.prototype.getChannel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.TestConfigRequest.prototype.setChannel = function(value) {
+  jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string Value = 4;
+ * @return {string}
+ */
+proto.firestarter.TestConfigRequest.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.TestConfigRequest.prototype.setValue = function(value) {
+  jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional bool Send = 5;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.firestarter.TestConfigRequest.prototype.getSend = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 5, false));
+};
+
+
+/** @param {boolean} value */
+proto.firestarter.TestConfigRequest.prototype.setSend = function(value) {
+  jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.TestConfigResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.TestConfigResponse.repeatedFields_, null);
+};
+goog.inherits(proto.firestarter.TestConfigResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.TestConfigResponse.displayName = 'proto.firestarter.TestConfigResponse';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.firestarter.TestConfigResponse.repeatedFields_ = [2,6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.TestConfigResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.TestConfigResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.TestConfigResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.TestConfigResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    matched: jspb.Message.getFieldWithDefault(msg, 1, false),
+    groupsList: jspb.Message.getRepeatedField(msg, 2),
+    text: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    url: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    body: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    headersList: jspb.Message.toObjectList(msg.getHeadersList(),
+    proto.firestarter.Header.toObject, includeInstance),
+    statuscode: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    responsebody: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    response: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    error: jspb.Message.getFieldWithDefault(msg, 10, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.TestConfigResponse}
+ */
+proto.firestarter.TestConfigResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.TestConfigResponse;
+  return proto.firestarter.TestConfigResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.TestConfigResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.TestConfigResponse}
+ */
+proto.firestarter.TestConfigResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMatched(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addGroups(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setText(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBody(value);
+      break;
+    case 6:
+      var value = new proto.firestarter.Header;
+      reader.readMessage(value,proto.firestarter.Header.deserializeBinaryFromReader);
+      msg.addHeaders(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setStatuscode(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResponsebody(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResponse(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.TestConfigResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.TestConfigResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.TestConfigResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.TestConfigResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMatched();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getText();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getBody();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getHeadersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.firestarter.Header.serializeBinaryToWriter
+    );
+  }
+  f = message.getStatuscode();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getResponsebody();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getResponse();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool Matched = 1;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.firestarter.TestConfigResponse.prototype.getMatched = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 1, false));
+};
+
+
+/** @param {boolean} value */
+proto.firestarter.TestConfigResponse.prototype.setMatched = function(value) {
+  jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * repeated string Groups = 2;
+ * @return {!Array.<string>}
+ */
+proto.firestarter.TestConfigResponse.prototype.getGroupsList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.firestarter.TestConfigResponse.prototype.setGroupsList = function(value) {
+  jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.firestarter.TestConfigResponse.prototype.addGroups = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+proto.firestarter.TestConfigResponse.prototype.clearGroupsList = function() {
+  this.setGroupsList([]);
+};
+
+
+/**
+ * optional string Text = 3;
+ * @return {string}
+ */
+proto.firestarter.TestConfigResponse.prototype.getText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.TestConfigResponse.prototype.setText = function(value) {
+  jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string URL = 4;
+ * @return {string}
+ */
+proto.firestarter.TestConfigResponse.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.TestConfigResponse.prototype.setUrl = function(value) {
+  jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string Body = 5;
+ * @return {string}
+ */
+proto.firestarter.TestConfigResponse.prototype.getBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.TestConfigResponse.prototype.setBody = function(value) {
+  jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * repeated Header Headers = 6;
+ * @return {!Array.<!proto.firestarter.Header>}
+ */
+proto.firestarter.TestConfigResponse.prototype.getHeadersList = function() {
+  return /** @type{!Array.<!proto.firestarter.Header>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.firestarter.Header, 6));
+};
+
+
+/** @param {!Array.<!proto.firestarter.Header>} value */
+proto.firestarter.TestConfigResponse.prototype.setHeadersList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.firestarter.Header=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.firestarter.Header}
+ */
+proto.firestarter.TestConfigResponse.prototype.addHeaders = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.firestarter.Header, opt_index);
+};
+
+
+proto.firestarter.TestConfigResponse.prototype.clearHeadersList = function() {
+  this.setHeadersList([]);
+};
+
+
+/**
+ * optional int32 StatusCode = 7;
+ * @return {number}
+ */
+proto.firestarter.TestConfigResponse.prototype.getStatuscode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/** @param {number} value */
+proto.firestarter.TestConfigResponse.prototype.setStatuscode = function(value) {
+  jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional string ResponseBody = 8;
+ * @return {string}
+ */
+proto.firestarter.TestConfigResponse.prototype.getResponsebody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.TestConfigResponse.prototype.setResponsebody = function(value) {
+  jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional string Response = 9;
+ * @return {string}
+ */
+proto.firestarter.TestConfigResponse.prototype.getResponse = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.TestConfigResponse.prototype.setResponse = function(value) {
+  jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional string Error = 10;
+ * @return {string}
+ */
+proto.firestarter.TestConfigResponse.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.TestConfigResponse.prototype.setError = function(value) {
+  jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.Secret = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.firestarter.Secret, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.Secret.displayName = 'proto.firestarter.Secret';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.Secret.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.Secret.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.Secret} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Secret.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    key: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.Secret}
+ */
+proto.firestarter.Secret.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.Secret;
+  return proto.firestarter.Secret.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.Secret} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.Secret}
+ */
+proto.firestarter.Secret.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKey(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.Secret.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.Secret.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.Secret} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Secret.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKey();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Key = 1;
+ * @return {string}
+ */
+proto.firestarter.Secret.prototype.getKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Secret.prototype.setKey = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string Value = 2;
+ * @return {string}
+ */
+proto.firestarter.Secret.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Secret.prototype.setValue = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.Header = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.firestarter.Header, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.Header.displayName = 'proto.firestarter.Header';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.Header.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.Header.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.Header} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Header.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    key: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.Header}
+ */
+proto.firestarter.Header.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.Header;
+  return proto.firestarter.Header.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.Header} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.Header}
+ */
+proto.firestarter.Header.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKey(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.Header.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.Header.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.Header} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Header.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKey();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Key = 1;
+ * @return {string}
+ */
+proto.firestarter.Header.prototype.getKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Header.prototype.setKey = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string Value = 2;
+ * @return {string}
+ */
+proto.firestarter.Header.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Header.prototype.setValue = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.Step = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.Step.repeatedFields_, null);
+};
+goog.inherits(proto.firestarter.Step, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.Step.displayName = 'proto.firestarter.Step';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.firestarter.Step.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.Step.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.Step.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.Step} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Step.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    text: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    optionsList: jspb.Message.getRepeatedField(msg, 3)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.Step}
+ */
+proto.firestarter.Step.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.Step;
+  return proto.firestarter.Step.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.Step} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.Step}
+ */
+proto.firestarter.Step.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setText(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addOptions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.Step.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.Step.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.Step} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Step.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getText();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getOptionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Name = 1;
+ * @return {string}
+ */
+proto.firestarter.Step.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Step.prototype.setName = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string Text = 2;
+ * @return {string}
+ */
+proto.firestarter.Step.prototype.getText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Step.prototype.setText = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated string Options = 3;
+ * @return {!Array.<string>}
+ */
+proto.firestarter.Step.prototype.getOptionsList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.firestarter.Step.prototype.setOptionsList = function(value) {
+  jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.firestarter.Step.prototype.addOptions = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+proto.firestarter.Step.prototype.clearOptionsList = function() {
+  this.setOptionsList([]);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.Config = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.Config.repeatedFields_, null);
+};
+goog.inherits(proto.firestarter.Config, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.Config.displayName = 'proto.firestarter.Config';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.firestarter.Config.repeatedFields_ = [3,9,10,13,17,23,25,28,29];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.Config.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.Config.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.Config} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Config.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    title: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    id: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    channelsList: jspb.Message.getRepeatedField(msg, 3),
+    texttemplate: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    regexp: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    urltemplate: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    bodytemplate: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    confirm: jspb.Message.getFieldWithDefault(msg, 8, false),
+    actionsList: jspb.Message.getRepeatedField(msg, 9),
+    secretsList: jspb.Message.toObjectList(msg.getSecretsList(),
+    proto.firestarter.Secret.toObject, includeInstance),
+    method: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    contenttype: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    headersList: jspb.Message.toObjectList(msg.getHeadersList(),
+    proto.firestarter.Header.toObject, includeInstance),
+    responsetemplate: jspb.Message.getFieldWithDefault(msg, 14, ""),
+    retrymaxattempts: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    retrybackoff: jspb.Message.getFieldWithDefault(msg, 16, ""),
+    retrystatuscodesList: jspb.Message.getRepeatedField(msg, 17),
+    timeout: jspb.Message.getFieldWithDefault(msg, 18, ""),
+    team: jspb.Message.getFieldWithDefault(msg, 19, ""),
+    command: jspb.Message.getFieldWithDefault(msg, 20, ""),
+    reaction: jspb.Message.getFieldWithDefault(msg, 21, ""),
+    schedule: jspb.Message.getFieldWithDefault(msg, 22, ""),
+    stepsList: jspb.Message.toObjectList(msg.getStepsList(),
+    proto.firestarter.Step.toObject, includeInstance),
+    optionsurl: jspb.Message.getFieldWithDefault(msg, 24, ""),
+    approversList: jspb.Message.getRepeatedField(msg, 25),
+    requiredapprovals: jspb.Message.getFieldWithDefault(msg, 26, 0),
+    denyselfapproval: jspb.Message.getFieldWithDefault(msg, 27, false),
+    allowedusersList: jspb.Message.getRepeatedField(msg, 28),
+    deniedusersList: jspb.Message.getRepeatedField(msg, 29),
+    priority: jspb.Message.getFieldWithDefault(msg, 30, 0),
+    matchmode: jspb.Message.getFieldWithDefault(msg, 31, ""),
+    replyinthread: jspb.Message.getFieldWithDefault(msg, 32, false),
+    threadmatch: jspb.Message.getFieldWithDefault(msg, 33, ""),
+    statusurl: jspb.Message.getFieldWithDefault(msg, 34, ""),
+    statusinterval: jspb.Message.getFieldWithDefault(msg, 35, ""),
+    statustimeout: jspb.Message.getFieldWithDefault(msg, 36, ""),
+    statussuccess: jspb.Message.getFieldWithDefault(msg, 37, ""),
+    statusfailure: jspb.Message.getFieldWithDefault(msg, 38, ""),
+    statuspending: jspb.Message.getFieldWithDefault(msg, 39, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.Config}
+ */
+proto.firestarter.Config.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.Config;
+  return proto.firestarter.Config.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.Config} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.Config}
+ */
+proto.firestarter.Config.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTitle(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addChannels(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTexttemplate(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRegexp(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrltemplate(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodytemplate(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setConfirm(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addActions(value);
+      break;
+    case 10:
+      var value = new proto.firestarter.Secret;
+      reader.readMessage(value,proto.firestarter.Secret.deserializeBinaryFromReader);
+      msg.addSecrets(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMethod(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContenttype(value);
+      break;
+    case 13:
+      var value = new proto.firestarter.Header;
+      reader.readMessage(value,proto.firestarter.Header.deserializeBinaryFromReader);
+      msg.addHeaders(value);
+      break;
+    case 14:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResponsetemplate(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRetrymaxattempts(value);
+      break;
+    case 16:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRetrybackoff(value);
+      break;
+    case 17:
+      var value = /** @type {!Array.<number>} */ (reader.readPackedInt32());
+      msg.setRetrystatuscodesList(value);
+      break;
+    case 18:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimeout(value);
+      break;
+    case 19:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTeam(value);
+      break;
+    case 20:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommand(value);
+      break;
+    case 21:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReaction(value);
+      break;
+    case 22:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSchedule(value);
+      break;
+    case 23:
+      var value = new proto.firestarter.Step;
+      reader.readMessage(value,proto.firestarter.Step.deserializeBinaryFromReader);
+      msg.addSteps(value);
+      break;
+    case 24:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOptionsurl(value);
+      break;
+    case 25:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addApprovers(value);
+      break;
+    case 26:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRequiredapprovals(value);
+      break;
+    case 27:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDenyselfapproval(value);
+      break;
+    case 28:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addAllowedusers(value);
+      break;
+    case 29:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addDeniedusers(value);
+      break;
+    case 30:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPriority(value);
+      break;
+    case 31:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMatchmode(value);
+      break;
+    case 32:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReplyinthread(value);
+      break;
+    case 33:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setThreadmatch(value);
+      break;
+    case 34:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStatusurl(value);
+      break;
+    case 35:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStatusinterval(value);
+      break;
+    case 36:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStatustimeout(value);
+      break;
+    case 37:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStatussuccess(value);
+      break;
+    case 38:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStatusfailure(value);
+      break;
+    case 39:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStatuspending(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.Config.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.Config.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.Config} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Config.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTitle();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getChannelsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getTexttemplate();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getRegexp();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getUrltemplate();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getBodytemplate();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getConfirm();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getActionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      9,
+      f
+    );
+  }
+  f = message.getSecretsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      10,
+      f,
+      proto.firestarter.Secret.serializeBinaryToWriter
+    );
+  }
+  f = message.getMethod();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getContenttype();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getHeadersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      13,
+      f,
+      proto.firestarter.Header.serializeBinaryToWriter
+    );
+  }
+  f = message.getResponsetemplate();
+  if (f.length > 0) {
+    writer.writeString(
+      14,
+      f
+    );
+  }
+  f = message.getRetrymaxattempts();
+  if (f !== 0) {
+    writer.writeInt32(
+      15,
+      f
+    );
+  }
+  f = message.getRetrybackoff();
+  if (f.length > 0) {
+    writer.writeString(
+      16,
+      f
+    );
+  }
+  f = message.getRetrystatuscodesList();
+  if (f.length > 0) {
+    writer.writePackedInt32(
+      17,
+      f
+    );
+  }
+  f = message.getTimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      18,
+      f
+    );
+  }
+  f = message.getTeam();
+  if (f.length > 0) {
+    writer.writeString(
+      19,
+      f
+    );
+  }
+  f = message.getCommand();
+  if (f.length > 0) {
+    writer.writeString(
+      20,
+      f
+    );
+  }
+  f = message.getReaction();
+  if (f.length > 0) {
+    writer.writeString(
+      21,
+      f
+    );
+  }
+  f = message.getSchedule();
+  if (f.length > 0) {
+    writer.writeString(
+      22,
+      f
+    );
+  }
+  f = message.getStepsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      23,
+      f,
+      proto.firestarter.Step.serializeBinaryToWriter
+    );
+  }
+  f = message.getOptionsurl();
+  if (f.length > 0) {
+    writer.writeString(
+      24,
+      f
+    );
+  }
+  f = message.getApproversList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      25,
+      f
+    );
+  }
+  f = message.getRequiredapprovals();
+  if (f !== 0) {
+    writer.writeInt32(
+      26,
+      f
+    );
+  }
+  f = message.getDenyselfapproval();
+  if (f) {
+    writer.writeBool(
+      27,
+      f
+    );
+  }
+  f = message.getAllowedusersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      28,
+      f
+    );
+  }
+  f = message.getDeniedusersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      29,
+      f
+    );
+  }
+  f = message.getPriority();
+  if (f !== 0) {
+    writer.writeInt32(
+      30,
+      f
+    );
+  }
+  f = message.getMatchmode();
+  if (f.length > 0) {
+    writer.writeString(
+      31,
+      f
+    );
+  }
+  f = message.getReplyinthread();
+  if (f) {
+    writer.writeBool(
+      32,
+      f
+    );
+  }
+  f = message.getThreadmatch();
+  if (f.length > 0) {
+    writer.writeString(
+      33,
+      f
+    );
+  }
+  f = message.getStatusurl();
+  if (f.length > 0) {
+    writer.writeString(
+      34,
+      f
+    );
+  }
+  f = message.getStatusinterval();
+  if (f.length > 0) {
+    writer.writeString(
+      35,
+      f
+    );
+  }
+  f = message.getStatustimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      36,
+      f
+    );
+  }
+  f = message.getStatussuccess();
+  if (f.length > 0) {
+    writer.writeString(
+      37,
+      f
+    );
+  }
+  f = message.getStatusfailure();
+  if (f.length > 0) {
+    writer.writeString(
+      38,
+      f
+    );
+  }
+  f = message.getStatuspending();
+  if (f.length > 0) {
+    writer.writeString(
+      39,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Title = 1;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getTitle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setTitle = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string ID = 2;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated string Channels = 3;
+ * @return {!Array.<string>}
+ */
+proto.firestarter.Config.prototype.getChannelsList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.firestarter.Config.prototype.setChannelsList = function(value) {
+  jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.firestarter.Config.prototype.addChannels = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+proto.firestarter.Config.prototype.clearChannelsList = function() {
+  this.setChannelsList([]);
+};
+
+
+/**
+ * optional string TextTemplate = 4;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getTexttemplate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setTexttemplate = function(value) {
+  jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string Regexp = 5;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getRegexp = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setRegexp = function(value) {
+  jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string URLTemplate = 6;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getUrltemplate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setUrltemplate = function(value) {
+  jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string BodyTemplate = 7;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getBodytemplate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setBodytemplate = function(value) {
+  jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional bool Confirm = 8;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.firestarter.Config.prototype.getConfirm = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 8, false));
+};
+
+
+/** @param {boolean} value */
+proto.firestarter.Config.prototype.setConfirm = function(value) {
+  jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * repeated string Actions = 9;
+ * @return {!Array.<string>}
+ */
+proto.firestarter.Config.prototype.getActionsList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 9));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.firestarter.Config.prototype.setActionsList = function(value) {
+  jspb.Message.setField(this, 9, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.firestarter.Config.prototype.addActions = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 9, value, opt_index);
+};
+
+
+proto.firestarter.Config.prototype.clearActionsList = function() {
+  this.setActionsList([]);
+};
+
+
+/**
+ * repeated Secret Secrets = 10;
+ * @return {!Array.<!proto.firestarter.Secret>}
+ */
+proto.firestarter.Config.prototype.getSecretsList = function() {
+  return /** @type{!Array.<!proto.firestarter.Secret>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.firestarter.Secret, 10));
+};
+
+
+/** @param {!Array.<!proto.firestarter.Secret>} value */
+proto.firestarter.Config.prototype.setSecretsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 10, value);
+};
+
+
+/**
+ * @param {!proto.firestarter.Secret=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.firestarter.Secret}
+ */
+proto.firestarter.Config.prototype.addSecrets = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 10, opt_value, proto.firestarter.Secret, opt_index);
+};
+
+
+proto.firestarter.Config.prototype.clearSecretsList = function() {
+  this.setSecretsList([]);
+};
+
+
+/**
+ * optional string Method = 11;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getMethod = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setMethod = function(value) {
+  jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * optional string ContentType = 12;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getContenttype = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setContenttype = function(value) {
+  jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+/**
+ * repeated Header Headers = 13;
+ * @return {!Array.<!proto.firestarter.Header>}
+ */
+proto.firestarter.Config.prototype.getHeadersList = function() {
+  return /** @type{!Array.<!proto.firestarter.Header>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.firestarter.Header, 13));
+};
+
+
+/** @param {!Array.<!proto.firestarter.Header>} value */
+proto.firestarter.Config.prototype.setHeadersList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 13, value);
+};
+
+
+/**
+ * @param {!proto.firestarter.Header=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.firestarter.Header}
+ */
+proto.firestarter.Config.prototype.addHeaders = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 13, opt_value, proto.firestarter.Header, opt_index);
+};
+
+
+proto.firestarter.Config.prototype.clearHeadersList = function() {
+  this.setHeadersList([]);
+};
+
+
+/**
+ * optional string ResponseTemplate = 14;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getResponsetemplate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setResponsetemplate = function(value) {
+  jspb.Message.setProto3StringField(this, 14, value);
+};
+
+
+/**
+ * optional int32 RetryMaxAttempts = 15;
+ * @return {number}
+ */
+proto.firestarter.Config.prototype.getRetrymaxattempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
+
+
+/** @param {number} value */
+proto.firestarter.Config.prototype.setRetrymaxattempts = function(value) {
+  jspb.Message.setProto3IntField(this, 15, value);
+};
+
+
+/**
+ * optional string RetryBackoff = 16;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getRetrybackoff = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setRetrybackoff = function(value) {
+  jspb.Message.setProto3StringField(this, 16, value);
+};
+
+
+/**
+ * repeated int32 RetryStatusCodes = 17;
+ * @return {!Array.<number>}
+ */
+proto.firestarter.Config.prototype.getRetrystatuscodesList = function() {
+  return /** @type {!Array.<number>} */ (jspb.Message.getRepeatedField(this, 17));
+};
+
+
+/** @param {!Array.<number>} value */
+proto.firestarter.Config.prototype.setRetrystatuscodesList = function(value) {
+  jspb.Message.setField(this, 17, value || []);
+};
+
+
+/**
+ * @param {!number} value
+ * @param {number=} opt_index
+ */
+proto.firestarter.Config.prototype.addRetrystatuscodes = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 17, value, opt_index);
+};
+
+
+proto.firestarter.Config.prototype.clearRetrystatuscodesList = function() {
+  this.setRetrystatuscodesList([]);
+};
+
+
+/**
+ * optional string Timeout = 18;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 18, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setTimeout = function(value) {
+  jspb.Message.setProto3StringField(this, 18, value);
+};
+
+
+/**
+ * optional string Team = 19;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getTeam = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setTeam = function(value) {
+  jspb.Message.setProto3StringField(this, 19, value);
+};
+
+
+/**
+ * optional string Command = 20;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getCommand = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 20, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setCommand = function(value) {
+  jspb.Message.setProto3StringField(this, 20, value);
+};
+
+
+/**
+ * optional string Reaction = 21;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getReaction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 21, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setReaction = function(value) {
+  jspb.Message.setProto3StringField(this, 21, value);
+};
+
+
+/**
+ * optional string Schedule = 22;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getSchedule = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 22, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setSchedule = function(value) {
+  jspb.Message.setProto3StringField(this, 22, value);
+};
+
+
+/**
+ * repeated Step Steps = 23;
+ * @return {!Array.<!proto.firestarter.Step>}
+ */
+proto.firestarter.Config.prototype.getStepsList = function() {
+  return /** @type{!Array.<!proto.firestarter.Step>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.firestarter.Step, 23));
+};
+
+
+/** @param {!Array.<!proto.firestarter.Step>} value */
+proto.firestarter.Config.prototype.setStepsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 23, value);
+};
+
+
+/**
+ * @param {!proto.firestarter.Step=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.firestarter.Step}
+ */
+proto.firestarter.Config.prototype.addSteps = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 23, opt_value, proto.firestarter.Step, opt_index);
+};
+
+
+proto.firestarter.Config.prototype.clearStepsList = function() {
+  this.setStepsList([]);
+};
+
+
+/**
+ * optional string OptionsURL = 24;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getOptionsurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 24, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setOptionsurl = function(value) {
+  jspb.Message.setProto3StringField(this, 24, value);
+};
+
+
+/**
+ * repeated string Approvers = 25;
+ * @return {!Array.<string>}
+ */
+proto.firestarter.Config.prototype.getApproversList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 25));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.firestarter.Config.prototype.setApproversList = function(value) {
+  jspb.Message.setField(this, 25, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.firestarter.Config.prototype.addApprovers = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 25, value, opt_index);
+};
+
+
+proto.firestarter.Config.prototype.clearApproversList = function() {
+  this.setApproversList([]);
+};
+
+
+/**
+ * optional int32 RequiredApprovals = 26;
+ * @return {number}
+ */
+proto.firestarter.Config.prototype.getRequiredapprovals = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 26, 0));
+};
+
+
+/** @param {number} value */
+proto.firestarter.Config.prototype.setRequiredapprovals = function(value) {
+  jspb.Message.setProto3IntField(this, 26, value);
+};
+
+
+/**
+ * optional bool DenySelfApproval = 27;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.firestarter.Config.prototype.getDenyselfapproval = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 27, false));
+};
+
+
+/** @param {boolean} value */
+proto.firestarter.Config.prototype.setDenyselfapproval = function(value) {
+  jspb.Message.setProto3BooleanField(this, 27, value);
+};
+
+
+/**
+ * repeated string AllowedUsers = 28;
+ * @return {!Array.<string>}
+ */
+proto.firestarter.Config.prototype.getAllowedusersList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 28));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.firestarter.Config.prototype.setAllowedusersList = function(value) {
+  jspb.Message.setField(this, 28, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.firestarter.Config.prototype.addAllowedusers = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 28, value, opt_index);
+};
+
+
+proto.firestarter.Config.prototype.clearAllowedusersList = function() {
+  this.setAllowedusersList([]);
+};
+
+
+/**
+ * repeated string DeniedUsers = 29;
+ * @return {!Array.<string>}
+ */
+proto.firestarter.Config.prototype.getDeniedusersList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 29));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.firestarter.Config.prototype.setDeniedusersList = function(value) {
+  jspb.Message.setField(this, 29, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.firestarter.Config.prototype.addDeniedusers = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 29, value, opt_index);
+};
+
+
+proto.firestarter.Config.prototype.clearDeniedusersList = function() {
+  this.setDeniedusersList([]);
+};
+
+
+/**
+ * optional int32 Priority = 30;
+ * @return {number}
+ */
+proto.firestarter.Config.prototype.getPriority = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 30, 0));
+};
+
+
+/** @param {number} value */
+proto.firestarter.Config.prototype.setPriority = function(value) {
+  jspb.Message.setProto3IntField(this, 30, value);
+};
+
+
+/**
+ * optional string MatchMode = 31;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getMatchmode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 31, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setMatchmode = function(value) {
+  jspb.Message.setProto3StringField(this, 31, value);
+};
+
+
+/**
+ * optional bool ReplyInThread = 32;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.firestarter.Config.prototype.getReplyinthread = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 32, false));
+};
+
+
+/** @param {boolean} value */
+proto.firestarter.Config.prototype.setReplyinthread = function(value) {
+  jspb.Message.setProto3BooleanField(this, 32, value);
+};
+
+
+/**
+ * optional string ThreadMatch = 33;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getThreadmatch = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 33, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setThreadmatch = function(value) {
+  jspb.Message.setProto3StringField(this, 33, value);
+};
+
+
+/**
+ * optional string StatusURL = 34;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getStatusurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 34, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setStatusurl = function(value) {
+  jspb.Message.setProto3StringField(this, 34, value);
+};
+
+
+/**
+ * optional string StatusInterval = 35;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getStatusinterval = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 35, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setStatusinterval = function(value) {
+  jspb.Message.setProto3StringField(this, 35, value);
+};
+
+
+/**
+ * optional string StatusTimeout = 36;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getStatustimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 36, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setStatustimeout = function(value) {
+  jspb.Message.setProto3StringField(this, 36, value);
+};
+
+
+/**
+ * optional string StatusSuccess = 37;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getStatussuccess = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 37, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setStatussuccess = function(value) {
+  jspb.Message.setProto3StringField(this, 37, value);
+};
+
+
+/**
+ * optional string StatusFailure = 38;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getStatusfailure = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 38, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setStatusfailure = function(value) {
+  jspb.Message.setProto3StringField(this, 38, value);
+};
+
+
+/**
+ * optional string StatusPending = 39;
+ * @return {string}
+ */
+proto.firestarter.Config.prototype.getStatuspending = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 39, ""));
+};
+
+
+/** @param {string} value */
+proto.firestarter.Config.prototype.setStatuspending = function(value) {
+  jspb.Message.setProto3StringField(this, 39, value);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.ConfigList = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.ConfigList.repeatedFields_, null);
+};
+goog.inherits(proto.firestarter.ConfigList, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.ConfigList.displayName = 'proto.firestarter.ConfigList';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.firestarter.ConfigList.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.ConfigList.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.ConfigList.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.ConfigList} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.ConfigList.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    configList: jspb.Message.toObjectList(msg.getConfigList(),
+    proto.firestarter.Config.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.ConfigList}
+ */
+proto.firestarter.ConfigList.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.ConfigList;
+  return proto.firestarter.ConfigList.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.ConfigList} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.ConfigList}
+ */
+proto.firestarter.ConfigList.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.firestarter.Config;
+      reader.readMessage(value,proto.firestarter.Config.deserializeBinaryFromReader);
+      msg.addConfig(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.ConfigList.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.ConfigList.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.ConfigList} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.ConfigList.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getConfigList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.firestarter.Config.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Config config = 1;
+ * @return {!Array.<!proto.firestarter.Config>}
+ */
+proto.firestarter.ConfigList.prototype.getConfigList = function() {
+  return /** @type{!Array.<!proto.firestarter.Config>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.firestarter.Config, 1));
+};
+
+
+/** @param {!Array.<!proto.firestarter.Config>} value */
+proto.firestarter.ConfigList.prototype.setConfigList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.firestarter.Config=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.firestarter.Config}
+ */
+proto.firestarter.ConfigList.prototype.addConfig = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.firestarter.Config, opt_index);
+};
+
+
+proto.firestarter.ConfigList.prototype.clearConfigList = function() {
+  this.setConfigList([]);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.Channels = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.Channels.repeatedFields_, null);
+};
+goog.inherits(proto.firestarter.Channels, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.Channels.displayName = 'proto.firestarter.Channels';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.firestarter.Channels.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.firestarter.Channels.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.Channels.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.Channels} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Channels.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    listList: jspb.Message.getRepeatedField(msg, 1)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.Channels}
+ */
+proto.firestarter.Channels.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.Channels;
+  return proto.firestarter.Channels.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.Channels} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.Channels}
+ */
+proto.firestarter.Channels.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addList(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.Channels.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.Channels.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.Channels} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.Channels.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getListList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string list = 1;
+ * @return {!Array.<string>}
+ */
+proto.firestarter.Channels.prototype.getListList = function() {
+  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/** @param {!Array.<string>} value */
+proto.firestarter.Channels.prototype.setListList = function(value) {
+  jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.firestarter.Channels.prototype.addList = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+proto.firestarter.Channels.prototype.clearListList = function() {
+  this.setListList([]);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.firestarter.GetChannelsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.firestarter.GetChannelsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.firestarter.GetChannelsRequest.displayName = 'proto.firestarter.GetChannelsRequest';
+}
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * repeated Secret Secrets = 10;
- * @return {!Array.<!proto.firestarter.Secret>}
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.firestarter.Config.prototype.getSecretsList = function() {
-  return /** @type{!Array.<!proto.firestarter.Secret>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.firestarter.Secret, 10));
+proto.firestarter.GetChannelsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.GetChannelsRequest.toObject(opt_includeInstance, this);
 };
 
 
-/** @param {!Array.<!proto.firestarter.Secret>} value */
-proto.firestarter.Config.prototype.setSecretsList = function(value) {
-  jspb.Message.setRepeatedWrapperField(this, 10, value);
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.firestarter.GetChannelsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.GetChannelsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!proto.firestarter.Secret=} opt_value
- * @param {number=} opt_index
- * @return {!proto.firestarter.Secret}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.firestarter.GetChannelsRequest}
  */
-proto.firestarter.Config.prototype.addSecrets = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 10, opt_value, proto.firestarter.Secret, opt_index);
+proto.firestarter.GetChannelsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.firestarter.GetChannelsRequest;
+  return proto.firestarter.GetChannelsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
-proto.firestarter.Config.prototype.clearSecretsList = function() {
-  this.setSecretsList([]);
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.firestarter.GetChannelsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.firestarter.GetChannelsRequest}
+ */
+proto.firestarter.GetChannelsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.firestarter.GetChannelsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.firestarter.GetChannelsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.firestarter.GetChannelsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.firestarter.GetChannelsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
@@ -1721,20 +5862,13 @@ proto.firestarter.Config.prototype.clearSecretsList = function() {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.firestarter.ConfigList = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.ConfigList.repeatedFields_, null);
+proto.firestarter.Member = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.firestarter.ConfigList, jspb.Message);
+goog.inherits(proto.firestarter.Member, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.firestarter.ConfigList.displayName = 'proto.firestarter.ConfigList';
+  proto.firestarter.Member.displayName = 'proto.firestarter.Member';
 }
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.firestarter.ConfigList.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1748,8 +5882,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.firestarter.ConfigList.prototype.toObject = function(opt_includeInstance) {
-  return proto.firestarter.ConfigList.toObject(opt_includeInstance, this);
+proto.firestarter.Member.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.Member.toObject(opt_includeInstance, this);
 };
 
 
@@ -1758,14 +5892,15 @@ proto.firestarter.ConfigList.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.firestarter.ConfigList} msg The msg instance to transform.
+ * @param {!proto.firestarter.Member} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.firestarter.ConfigList.toObject = function(includeInstance, msg) {
+proto.firestarter.Member.toObject = function(includeInstance, msg) {
   var f, obj = {
-    configList: jspb.Message.toObjectList(msg.getConfigList(),
-    proto.firestarter.Config.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    group: jspb.Message.getFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -1779,23 +5914,23 @@ proto.firestarter.ConfigList.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.firestarter.ConfigList}
+ * @return {!proto.firestarter.Member}
  */
-proto.firestarter.ConfigList.deserializeBinary = function(bytes) {
+proto.firestarter.Member.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.firestarter.ConfigList;
-  return proto.firestarter.ConfigList.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.firestarter.Member;
+  return proto.firestarter.Member.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.firestarter.ConfigList} msg The message object to deserialize into.
+ * @param {!proto.firestarter.Member} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.firestarter.ConfigList}
+ * @return {!proto.firestarter.Member}
  */
-proto.firestarter.ConfigList.deserializeBinaryFromReader = function(msg, reader) {
+proto.firestarter.Member.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1803,9 +5938,16 @@ proto.firestarter.ConfigList.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.firestarter.Config;
-      reader.readMessage(value,proto.firestarter.Config.deserializeBinaryFromReader);
-      msg.addConfig(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setGroup(value);
       break;
     default:
       reader.skipField();
@@ -1820,9 +5962,9 @@ proto.firestarter.ConfigList.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.firestarter.ConfigList.prototype.serializeBinary = function() {
+proto.firestarter.Member.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.firestarter.ConfigList.serializeBinaryToWriter(this, writer);
+  proto.firestarter.Member.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1830,51 +5972,80 @@ proto.firestarter.ConfigList.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.firestarter.ConfigList} message
+ * @param {!proto.firestarter.Member} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.firestarter.ConfigList.serializeBinaryToWriter = function(message, writer) {
+proto.firestarter.Member.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getConfigList();
+  f = message.getId();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.firestarter.Config.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getGroup();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
     );
   }
 };
 
 
 /**
- * repeated Config config = 1;
- * @return {!Array.<!proto.firestarter.Config>}
+ * optional string ID = 1;
+ * @return {string}
  */
-proto.firestarter.ConfigList.prototype.getConfigList = function() {
-  return /** @type{!Array.<!proto.firestarter.Config>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.firestarter.Config, 1));
+proto.firestarter.Member.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
-/** @param {!Array.<!proto.firestarter.Config>} value */
-proto.firestarter.ConfigList.prototype.setConfigList = function(value) {
-  jspb.Message.setRepeatedWrapperField(this, 1, value);
+/** @param {string} value */
+proto.firestarter.Member.prototype.setId = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.firestarter.Config=} opt_value
- * @param {number=} opt_index
- * @return {!proto.firestarter.Config}
+ * optional string Name = 2;
+ * @return {string}
  */
-proto.firestarter.ConfigList.prototype.addConfig = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.firestarter.Config, opt_index);
+proto.firestarter.Member.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
-proto.firestarter.ConfigList.prototype.clearConfigList = function() {
-  this.setConfigList([]);
+/** @param {string} value */
+proto.firestarter.Member.prototype.setName = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool Group = 3;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.firestarter.Member.prototype.getGroup = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 3, false));
+};
+
+
+/** @param {boolean} value */
+proto.firestarter.Member.prototype.setGroup = function(value) {
+  jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
@@ -1889,19 +6060,19 @@ proto.firestarter.ConfigList.prototype.clearConfigList = function() {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.firestarter.Channels = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.Channels.repeatedFields_, null);
+proto.firestarter.Members = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.firestarter.Members.repeatedFields_, null);
 };
-goog.inherits(proto.firestarter.Channels, jspb.Message);
+goog.inherits(proto.firestarter.Members, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.firestarter.Channels.displayName = 'proto.firestarter.Channels';
+  proto.firestarter.Members.displayName = 'proto.firestarter.Members';
 }
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.firestarter.Channels.repeatedFields_ = [1];
+proto.firestarter.Members.repeatedFields_ = [1];
 
 
 
@@ -1916,8 +6087,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.firestarter.Channels.prototype.toObject = function(opt_includeInstance) {
-  return proto.firestarter.Channels.toObject(opt_includeInstance, this);
+proto.firestarter.Members.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.Members.toObject(opt_includeInstance, this);
 };
 
 
@@ -1926,13 +6097,14 @@ proto.firestarter.Channels.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.firestarter.Channels} msg The msg instance to transform.
+ * @param {!proto.firestarter.Members} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.firestarter.Channels.toObject = function(includeInstance, msg) {
+proto.firestarter.Members.toObject = function(includeInstance, msg) {
   var f, obj = {
-    listList: jspb.Message.getRepeatedField(msg, 1)
+    listList: jspb.Message.toObjectList(msg.getListList(),
+    proto.firestarter.Member.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1946,23 +6118,23 @@ proto.firestarter.Channels.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.firestarter.Channels}
+ * @return {!proto.firestarter.Members}
  */
-proto.firestarter.Channels.deserializeBinary = function(bytes) {
+proto.firestarter.Members.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.firestarter.Channels;
-  return proto.firestarter.Channels.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.firestarter.Members;
+  return proto.firestarter.Members.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.firestarter.Channels} msg The message object to deserialize into.
+ * @param {!proto.firestarter.Members} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.firestarter.Channels}
+ * @return {!proto.firestarter.Members}
  */
-proto.firestarter.Channels.deserializeBinaryFromReader = function(msg, reader) {
+proto.firestarter.Members.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1970,7 +6142,8 @@ proto.firestarter.Channels.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
+      var value = new proto.firestarter.Member;
+      reader.readMessage(value,proto.firestarter.Member.deserializeBinaryFromReader);
       msg.addList(value);
       break;
     default:
@@ -1986,9 +6159,9 @@ proto.firestarter.Channels.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.firestarter.Channels.prototype.serializeBinary = function() {
+proto.firestarter.Members.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.firestarter.Channels.serializeBinaryToWriter(this, writer);
+  proto.firestarter.Members.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1996,47 +6169,50 @@ proto.firestarter.Channels.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.firestarter.Channels} message
+ * @param {!proto.firestarter.Members} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.firestarter.Channels.serializeBinaryToWriter = function(message, writer) {
+proto.firestarter.Members.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getListList();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.firestarter.Member.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated string list = 1;
- * @return {!Array.<string>}
+ * repeated Member list = 1;
+ * @return {!Array.<!proto.firestarter.Member>}
  */
-proto.firestarter.Channels.prototype.getListList = function() {
-  return /** @type {!Array.<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.firestarter.Members.prototype.getListList = function() {
+  return /** @type{!Array.<!proto.firestarter.Member>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.firestarter.Member, 1));
 };
 
 
-/** @param {!Array.<string>} value */
-proto.firestarter.Channels.prototype.setListList = function(value) {
-  jspb.Message.setField(this, 1, value || []);
+/** @param {!Array.<!proto.firestarter.Member>} value */
+proto.firestarter.Members.prototype.setListList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!string} value
+ * @param {!proto.firestarter.Member=} opt_value
  * @param {number=} opt_index
+ * @return {!proto.firestarter.Member}
  */
-proto.firestarter.Channels.prototype.addList = function(value, opt_index) {
-  jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.firestarter.Members.prototype.addList = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.firestarter.Member, opt_index);
 };
 
 
-proto.firestarter.Channels.prototype.clearListList = function() {
+proto.firestarter.Members.prototype.clearListList = function() {
   this.setListList([]);
 };
 
@@ -2052,12 +6228,12 @@ proto.firestarter.Channels.prototype.clearListList = function() {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.firestarter.GetChannelsRequest = function(opt_data) {
+proto.firestarter.GetMembersRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.firestarter.GetChannelsRequest, jspb.Message);
+goog.inherits(proto.firestarter.GetMembersRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.firestarter.GetChannelsRequest.displayName = 'proto.firestarter.GetChannelsRequest';
+  proto.firestarter.GetMembersRequest.displayName = 'proto.firestarter.GetMembersRequest';
 }
 
 
@@ -2072,8 +6248,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.firestarter.GetChannelsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.firestarter.GetChannelsRequest.toObject(opt_includeInstance, this);
+proto.firestarter.GetMembersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.firestarter.GetMembersRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2082,11 +6258,11 @@ proto.firestarter.GetChannelsRequest.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.firestarter.GetChannelsRequest} msg The msg instance to transform.
+ * @param {!proto.firestarter.GetMembersRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.firestarter.GetChannelsRequest.toObject = function(includeInstance, msg) {
+proto.firestarter.GetMembersRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -2102,23 +6278,23 @@ proto.firestarter.GetChannelsRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.firestarter.GetChannelsRequest}
+ * @return {!proto.firestarter.GetMembersRequest}
  */
-proto.firestarter.GetChannelsRequest.deserializeBinary = function(bytes) {
+proto.firestarter.GetMembersRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.firestarter.GetChannelsRequest;
-  return proto.firestarter.GetChannelsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.firestarter.GetMembersRequest;
+  return proto.firestarter.GetMembersRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.firestarter.GetChannelsRequest} msg The message object to deserialize into.
+ * @param {!proto.firestarter.GetMembersRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.firestarter.GetChannelsRequest}
+ * @return {!proto.firestarter.GetMembersRequest}
  */
-proto.firestarter.GetChannelsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.firestarter.GetMembersRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2138,9 +6314,9 @@ proto.firestarter.GetChannelsRequest.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.firestarter.GetChannelsRequest.prototype.serializeBinary = function() {
+proto.firestarter.GetMembersRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.firestarter.GetChannelsRequest.serializeBinaryToWriter(this, writer);
+  proto.firestarter.GetMembersRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2148,11 +6324,11 @@ proto.firestarter.GetChannelsRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.firestarter.GetChannelsRequest} message
+ * @param {!proto.firestarter.GetMembersRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.firestarter.GetChannelsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.firestarter.GetMembersRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
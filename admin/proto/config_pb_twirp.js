@@ -13,24 +13,30 @@ var pb = require("./config_pb.js");
 module.exports.createConfigServiceClient = function(baseurl, extraHeaders, useJSON) {
     var rpc = createClient(baseurl, "firestarter.ConfigService", "v5.0.0",  useJSON, extraHeaders === undefined ? {} : extraHeaders);
     return {
-        /**
-         * rpc DumpConfigList(DumpConfigListRequest) returns (ConfigList) {}
-         * rpc RestoreConfigList(RestoreConfigListRequest) returns (RestoreConfigListResponse) {}
-         */
+        dumpConfigList: function(data) { return rpc("DumpConfigList", rpc.buildMessage(pb.DumpConfigListRequest, data), pb.ConfigList); },
+        restoreConfigList: function(data) { return rpc("RestoreConfigList", rpc.buildMessage(pb.RestoreConfigListRequest, data), pb.RestoreConfigListResponse); },
         getConfigList: function(data) { return rpc("GetConfigList", rpc.buildMessage(pb.GetConfigListRequest, data), pb.ConfigList); },
         getConfig: function(data) { return rpc("GetConfig", rpc.buildMessage(pb.GetConfigRequest, data), pb.Config); },
         setConfig: function(data) { return rpc("SetConfig", rpc.buildMessage(pb.Config, data), pb.SetConfigResponse); },
         deleteConfig: function(data) { return rpc("DeleteConfig", rpc.buildMessage(pb.DeleteConfigRequest, data), pb.DeleteConfigResponse); },
         getChannels: function(data) { return rpc("GetChannels", rpc.buildMessage(pb.GetChannelsRequest, data), pb.Channels); },
-        /**
-         * rpc DumpConfigList(DumpConfigListRequest) returns (ConfigList) {}
-         * rpc RestoreConfigList(RestoreConfigListRequest) returns (RestoreConfigListResponse) {}
-         */
+        getMembers: function(data) { return rpc("GetMembers", rpc.buildMessage(pb.GetMembersRequest, data), pb.Members); },
+        listAuditEvents: function(data) { return rpc("ListAuditEvents", rpc.buildMessage(pb.ListAuditEventsRequest, data), pb.AuditEventList); },
+        getExecutions: function(data) { return rpc("GetExecutions", rpc.buildMessage(pb.GetExecutionsRequest, data), pb.ExecutionList); },
+        getExecution: function(data) { return rpc("GetExecution", rpc.buildMessage(pb.GetExecutionRequest, data), pb.Execution); },
+        testConfig: function(data) { return rpc("TestConfig", rpc.buildMessage(pb.TestConfigRequest, data), pb.TestConfigResponse); },
+        dumpConfigListRaw: function(data) { return rpc("DumpConfigList", data, pb.ConfigList); },
+        restoreConfigListRaw: function(data) { return rpc("RestoreConfigList", data, pb.RestoreConfigListResponse); },
         getConfigListRaw: function(data) { return rpc("GetConfigList", data, pb.ConfigList); },
         getConfigRaw: function(data) { return rpc("GetConfig", data, pb.Config); },
         setConfigRaw: function(data) { return rpc("SetConfig", data, pb.SetConfigResponse); },
         deleteConfigRaw: function(data) { return rpc("DeleteConfig", data, pb.DeleteConfigResponse); },
-        getChannelsRaw: function(data) { return rpc("GetChannels", data, pb.Channels); }
+        getChannelsRaw: function(data) { return rpc("GetChannels", data, pb.Channels); },
+        getMembersRaw: function(data) { return rpc("GetMembers", data, pb.Members); },
+        listAuditEventsRaw: function(data) { return rpc("ListAuditEvents", data, pb.AuditEventList); },
+        getExecutionsRaw: function(data) { return rpc("GetExecutions", data, pb.ExecutionList); },
+        getExecutionRaw: function(data) { return rpc("GetExecution", data, pb.Execution); },
+        testConfigRaw: function(data) { return rpc("TestConfig", data, pb.TestConfigResponse); }
     }
 }
 
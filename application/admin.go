@@ -2,39 +2,100 @@ package application
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"sort"
 
 	"github.com/juntaki/firestarter/domain"
 	proto "github.com/juntaki/firestarter/proto"
+	"github.com/rs/xid"
 	"github.com/twitchtv/twirp"
+	"go.uber.org/zap"
 )
 
 type AdminAPI struct {
-	ConfigRepository domain.ConfigRepository
-	ChatRepository   domain.ChatRepository
-	Validator        *domain.Validator
+	ConfigRepository    domain.ConfigRepository
+	ChatRepository      domain.ChatRepository
+	AuditRepository     domain.AuditRepository
+	ExecutionRepository domain.ExecutionRepository
+	Validator           *domain.Validator
+	Log                 *zap.SugaredLogger
 }
 
-func NewAdminAPI(configRepository domain.ConfigRepository, chatRepository domain.ChatRepository) *AdminAPI {
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+func NewAdminAPI(
+	configRepository domain.ConfigRepository,
+	chatRepository domain.ChatRepository,
+	auditRepository domain.AuditRepository,
+	executionRepository domain.ExecutionRepository,
+	log *zap.SugaredLogger,
+) *AdminAPI {
 	return &AdminAPI{
-		ConfigRepository: configRepository,
-		ChatRepository:   chatRepository,
-		Validator:        domain.NewValidator(),
+		ConfigRepository:    configRepository,
+		ChatRepository:      chatRepository,
+		AuditRepository:     auditRepository,
+		ExecutionRepository: executionRepository,
+		Validator:           domain.NewValidator(),
+		Log:                 log,
+	}
+}
+
+// audit records the event, failure is just logged not to block admin operation.
+func (a *AdminAPI) audit(event *domain.AuditEvent, err error) {
+	event.SetOutcome(err)
+	if err := a.AuditRepository.AddAuditEvent(event); err != nil {
+		a.Log.Errorw("Audit failed", zap.Error(err), zap.String("action", event.Action))
+	}
+}
+
+// identity returns the authenticated identity, which has the permission.
+func (a *AdminAPI) identity(ctx context.Context, permitted func(*domain.Identity) bool) (*domain.Identity, error) {
+	identity, ok := domain.IdentityFromContext(ctx)
+	if !ok {
+		return nil, twirp.NewError(twirp.Unauthenticated, "authentication required")
+	}
+	if !permitted(identity) {
+		return nil, twirp.NewError(twirp.PermissionDenied, "permission denied")
+	}
+	return identity, nil
+}
+
+// hideSecrets masks secrets, and removes them if the identity cannot see.
+func (a *AdminAPI) hideSecrets(identity *domain.Identity, config *domain.Config) {
+	if identity.CanSeeSecrets() {
+		config.Mask()
+	} else {
+		config.Secrets = map[string]string{}
 	}
 }
 
 func (a *AdminAPI) GetConfig(ctx context.Context, request *proto.GetConfigRequest) (*proto.Config, error) {
+	identity, err := a.identity(ctx, (*domain.Identity).CanView)
+	if err != nil {
+		return &proto.Config{}, err
+	}
+
 	config, err := a.ConfigRepository.GetConfig(request.ID)
 	if err != nil {
 		return &proto.Config{}, err
 	}
 
-	config.Mask()
+	a.hideSecrets(identity, config)
 	return a.configToPbConfig(config), nil
 }
 
 func (a *AdminAPI) GetConfigList(ctx context.Context, request *proto.GetConfigListRequest) (*proto.ConfigList, error) {
+	identity, err := a.identity(ctx, (*domain.Identity).CanView)
+	if err != nil {
+		return &proto.ConfigList{}, err
+	}
+
 	config, err := a.ConfigRepository.GetConfigList()
 	if err != nil {
 		return &proto.ConfigList{}, err
@@ -49,7 +110,7 @@ func (a *AdminAPI) GetConfigList(ctx context.Context, request *proto.GetConfigLi
 
 	result := &proto.ConfigList{}
 	for _, k := range keys {
-		config[k].Mask()
+		a.hideSecrets(identity, config[k])
 		result.Config = append(result.Config, a.configToPbConfig(config[k]))
 	}
 
@@ -58,7 +119,12 @@ func (a *AdminAPI) GetConfigList(ctx context.Context, request *proto.GetConfigLi
 
 func (a *AdminAPI) SetConfig(ctx context.Context, pbconfig *proto.Config) (*proto.SetConfigResponse, error) {
 	config := a.pbConfigToConfig(pbconfig)
-	err := a.Validator.ValidateConfig(config)
+	identity, err := a.identity(ctx, func(i *domain.Identity) bool { return i.CanEdit(config) })
+	if err != nil {
+		return &proto.SetConfigResponse{}, err
+	}
+
+	err = a.Validator.ValidateConfig(config)
 	if err != nil {
 		return &proto.SetConfigResponse{},
 			twirp.InvalidArgumentError(
@@ -67,6 +133,7 @@ func (a *AdminAPI) SetConfig(ctx context.Context, pbconfig *proto.Config) (*prot
 			)
 	}
 
+	var old *domain.Config
 	if config.CallbackID != "" {
 		if exist, err := a.ConfigRepository.IsExist(config.CallbackID); err != nil {
 			return &proto.SetConfigResponse{}, err
@@ -74,19 +141,60 @@ func (a *AdminAPI) SetConfig(ctx context.Context, pbconfig *proto.Config) (*prot
 			return &proto.SetConfigResponse{}, twirp.InvalidArgumentError(
 				"config.id", "Malformed request")
 		}
+
+		// Cannot take the config of other team.
+		old, err = a.ConfigRepository.GetConfig(config.CallbackID)
+		if err != nil {
+			return &proto.SetConfigResponse{}, err
+		}
+		if !identity.CanEdit(old) {
+			return &proto.SetConfigResponse{}, twirp.NewError(twirp.PermissionDenied, "permission denied")
+		}
+
+		if !identity.CanSeeSecrets() {
+			// Templates can send the secrets anywhere, e.g. in URL query.
+			if len(old.Secrets) > 0 && !config.HasSameSecretTemplates(old) {
+				return &proto.SetConfigResponse{}, twirp.NewError(twirp.PermissionDenied,
+					"only owners can change URL, body and headers of the config with secrets")
+			}
+			// Keep secrets as is.
+			config.Secrets = make(map[string]string)
+			for k := range old.Secrets {
+				config.Secrets[k] = domain.SercretValueMask
+			}
+		}
+	} else if !identity.CanSeeSecrets() {
+		config.Secrets = map[string]string{}
 	}
 
 	config.Hydrate()
+	event := domain.NewAuditEvent(identity.Name, domain.AuditActionSetConfig, config.CallbackID)
+	event.Diff = domain.DiffConfig(old, config)
 	err = a.ConfigRepository.SetConfig(config)
+	a.audit(event, err)
 	return &proto.SetConfigResponse{}, err
 }
 
 func (a *AdminAPI) DeleteConfig(ctx context.Context, r *proto.DeleteConfigRequest) (*proto.DeleteConfigResponse, error) {
-	err := a.ConfigRepository.DeleteConfig(r.ID)
+	identity, err := a.identity(ctx, (*domain.Identity).CanDelete)
+	if err != nil {
+		return &proto.DeleteConfigResponse{}, err
+	}
+
+	event := domain.NewAuditEvent(identity.Name, domain.AuditActionDeleteConfig, r.ID)
+	if old, err := a.ConfigRepository.GetConfig(r.ID); err == nil {
+		event.Diff = domain.DiffConfig(old, nil)
+	}
+	err = a.ConfigRepository.DeleteConfig(r.ID)
+	a.audit(event, err)
 	return &proto.DeleteConfigResponse{}, err
 }
 
 func (a *AdminAPI) GetChannels(ctx context.Context, req *proto.GetChannelsRequest) (*proto.Channels, error) {
+	if _, err := a.identity(ctx, (*domain.Identity).CanView); err != nil {
+		return &proto.Channels{}, err
+	}
+
 	ch, err := a.ChatRepository.GetChannels()
 	if err != nil {
 		return &proto.Channels{}, err
@@ -96,44 +204,482 @@ func (a *AdminAPI) GetChannels(ctx context.Context, req *proto.GetChannelsReques
 	}, nil
 }
 
+func (a *AdminAPI) GetMembers(ctx context.Context, req *proto.GetMembersRequest) (*proto.Members, error) {
+	if _, err := a.identity(ctx, (*domain.Identity).CanView); err != nil {
+		return &proto.Members{}, err
+	}
+
+	members, err := a.ChatRepository.GetMembers()
+	if err != nil {
+		return &proto.Members{}, err
+	}
+	ret := &proto.Members{}
+	for _, m := range members {
+		ret.List = append(ret.List, &proto.Member{
+			ID:    m.ID,
+			Name:  m.Name,
+			Group: m.Group,
+		})
+	}
+	return ret, nil
+}
+
+func (a *AdminAPI) DumpConfigList(ctx context.Context, r *proto.DumpConfigListRequest) (*proto.ConfigList, error) {
+	if _, err := a.identity(ctx, (*domain.Identity).CanSeeSecrets); err != nil {
+		return &proto.ConfigList{}, err
+	}
+	if r.Password == "" {
+		return &proto.ConfigList{}, twirp.RequiredArgumentError("password")
+	}
+
+	config, err := a.ConfigRepository.GetConfigList()
+	if err != nil {
+		return &proto.ConfigList{}, err
+	}
+
+	// sort by id
+	keys := make([]string, 0)
+	for k := range config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := &proto.ConfigList{}
+	for _, k := range keys {
+		for sk, sv := range config[k].Secrets {
+			encrypted, err := domain.EncryptSecret(r.Password, sv)
+			if err != nil {
+				return &proto.ConfigList{}, err
+			}
+			config[k].Secrets[sk] = encrypted
+		}
+		result.Config = append(result.Config, a.configToPbConfig(config[k]))
+	}
+
+	return result, nil
+}
+
+func (a *AdminAPI) RestoreConfigList(ctx context.Context, r *proto.RestoreConfigListRequest) (*proto.RestoreConfigListResponse, error) {
+	identity, err := a.identity(ctx, (*domain.Identity).CanDelete)
+	if err != nil {
+		return &proto.RestoreConfigListResponse{}, err
+	}
+	if r.Password == "" {
+		return &proto.RestoreConfigListResponse{}, twirp.RequiredArgumentError("password")
+	}
+	if r.ConfigList == nil {
+		return &proto.RestoreConfigListResponse{}, twirp.RequiredArgumentError("configList")
+	}
+
+	// Validate all before restore, not to restore partially.
+	configs := domain.ConfigMap{}
+	for i, pbconfig := range r.ConfigList.Config {
+		config := a.pbConfigToConfig(pbconfig)
+		for k, v := range config.Secrets {
+			decrypted, err := domain.DecryptSecret(r.Password, v)
+			if err != nil {
+				return &proto.RestoreConfigListResponse{},
+					twirp.InvalidArgumentError(
+						fmt.Sprintf("configList.config[%d].Secrets", i),
+						err.Error(),
+					)
+			}
+			config.Secrets[k] = decrypted
+		}
+
+		err := a.Validator.ValidateConfig(config)
+		if err != nil {
+			return &proto.RestoreConfigListResponse{},
+				twirp.InvalidArgumentError(
+					fmt.Sprintf("configList.config[%d]", i),
+					err.Error(),
+				)
+		}
+
+		config.Hydrate()
+		if _, ok := configs[config.CallbackID]; ok {
+			return &proto.RestoreConfigListResponse{},
+				twirp.InvalidArgumentError(
+					fmt.Sprintf("configList.config[%d].ID", i),
+					"Duplicated ID",
+				)
+		}
+		configs[config.CallbackID] = config
+	}
+
+	current, err := a.ConfigRepository.GetConfigList()
+	if err != nil {
+		return &proto.RestoreConfigListResponse{}, err
+	}
+	events := []*domain.AuditEvent{}
+	for _, config := range configs {
+		event := domain.NewAuditEvent(identity.Name, domain.AuditActionRestoreConfig, config.CallbackID)
+		event.Diff = domain.DiffConfig(current[config.CallbackID], config)
+		event.Detail = fmt.Sprintf("merge: %t", r.Merge)
+		events = append(events, event)
+	}
+
+	err = a.ConfigRepository.RestoreConfigList(configs, r.Merge)
+	for _, event := range events {
+		a.audit(event, err)
+	}
+	return &proto.RestoreConfigListResponse{}, err
+}
+
+func (a *AdminAPI) ListAuditEvents(ctx context.Context, r *proto.ListAuditEventsRequest) (*proto.AuditEventList, error) {
+	if _, err := a.identity(ctx, (*domain.Identity).CanView); err != nil {
+		return &proto.AuditEventList{}, err
+	}
+
+	filter := &domain.AuditFilter{
+		ConfigID: r.ConfigID,
+	}
+	if r.Since != 0 {
+		filter.Since = time.Unix(r.Since, 0)
+	}
+	if r.Until != 0 {
+		filter.Until = time.Unix(r.Until, 0)
+	}
+
+	events, err := a.AuditRepository.ListAuditEvents(filter)
+	if err != nil {
+		return &proto.AuditEventList{}, err
+	}
+
+	result := &proto.AuditEventList{}
+	for _, e := range events {
+		result.Events = append(result.Events, &proto.AuditEvent{
+			ID:       e.ID,
+			Time:     e.Time.Unix(),
+			Actor:    e.Actor,
+			Action:   e.Action,
+			ConfigID: e.ConfigID,
+			Diff:     e.Diff,
+			Detail:   e.Detail,
+			Outcome:  e.Outcome,
+		})
+	}
+	return result, nil
+}
+
+// GetExecutions returns executions newest first, PageToken is given by previous NextPageToken.
+func (a *AdminAPI) GetExecutions(ctx context.Context, r *proto.GetExecutionsRequest) (*proto.ExecutionList, error) {
+	if _, err := a.identity(ctx, (*domain.Identity).CanView); err != nil {
+		return &proto.ExecutionList{}, err
+	}
+
+	pageSize := int(r.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	offset := 0
+	if r.PageToken != "" {
+		var err error
+		offset, err = strconv.Atoi(r.PageToken)
+		if err != nil || offset < 0 {
+			return &proto.ExecutionList{}, twirp.InvalidArgumentError("PageToken", "is invalid")
+		}
+	}
+
+	// Get one more to know whether next page exists
+	filter := &domain.ExecutionFilter{ConfigID: r.ConfigID}
+	executions, err := a.ExecutionRepository.ListExecutions(filter, offset, pageSize+1)
+	if err != nil {
+		return &proto.ExecutionList{}, err
+	}
+
+	result := &proto.ExecutionList{}
+	if len(executions) > pageSize {
+		executions = executions[:pageSize]
+		result.NextPageToken = strconv.Itoa(offset + pageSize)
+	}
+	for _, e := range executions {
+		result.Executions = append(result.Executions, a.executionToPbExecution(e))
+	}
+	return result, nil
+}
+
+func (a *AdminAPI) GetExecution(ctx context.Context, r *proto.GetExecutionRequest) (*proto.Execution, error) {
+	if _, err := a.identity(ctx, (*domain.Identity).CanView); err != nil {
+		return &proto.Execution{}, err
+	}
+
+	execution, err := a.ExecutionRepository.GetExecution(r.ID)
+	if err != nil {
+		return &proto.Execution{}, twirp.NotFoundError(err.Error())
+	}
+	return a.executionToPbExecution(execution), nil
+}
+
+// TestConfig renders the config with the sample message, and sends the request if Send is set.
+// The config may be unsaved, masked or omitted secrets are taken from the saved one.
+func (a *AdminAPI) TestConfig(ctx context.Context, r *proto.TestConfigRequest) (*proto.TestConfigResponse, error) {
+	if r.Config == nil {
+		return &proto.TestConfigResponse{}, twirp.RequiredArgumentError("Config")
+	}
+	config := a.pbConfigToConfig(r.Config)
+	identity, err := a.identity(ctx, func(i *domain.Identity) bool { return i.CanEdit(config) })
+	if err != nil {
+		return &proto.TestConfigResponse{}, err
+	}
+
+	err = a.Validator.ValidateConfig(config)
+	if err != nil {
+		return &proto.TestConfigResponse{}, twirp.InvalidArgumentError("config", err.Error())
+	}
+
+	if config.CallbackID != "" {
+		if exist, err := a.ConfigRepository.IsExist(config.CallbackID); err != nil {
+			return &proto.TestConfigResponse{}, err
+		} else if exist {
+			old, err := a.ConfigRepository.GetConfig(config.CallbackID)
+			if err != nil {
+				return &proto.TestConfigResponse{}, err
+			}
+			if !identity.CanEdit(old) {
+				return &proto.TestConfigResponse{}, twirp.NewError(twirp.PermissionDenied, "permission denied")
+			}
+			if !identity.CanSeeSecrets() {
+				config.Secrets = old.Secrets
+			}
+			for k, v := range config.Secrets {
+				if v == domain.SercretValueMask {
+					config.Secrets[k] = old.Secrets[k]
+				}
+			}
+		}
+	}
+	// Templates can encode secrets beyond masking, e.g. printf or b64enc,
+	// so others render with placeholders and can't send.
+	if !identity.CanSeeSecrets() {
+		if r.Send {
+			return &proto.TestConfigResponse{}, twirp.NewError(twirp.PermissionDenied, "only owners can send with secrets")
+		}
+		config = config.WithSecretPlaceholders()
+	}
+	config.Hydrate()
+
+	result := &proto.TestConfigResponse{}
+	cm := domain.ConfigMap{config.CallbackID: config}
+	result.Matched = cm.FindMatched(r.Channel, r.Message, false) != nil
+	sess := &domain.SessionValue{
+		Matched: config.Regexp.FindStringSubmatch(r.Message),
+		Value:   r.Value,
+		ID:      xid.New().String(),
+		Context: domain.MessageContext{
+			UserName:    identity.Name,
+			ChannelName: r.Channel,
+			Time:        time.Now(),
+		},
+	}
+	result.Groups = sess.Matched
+
+	if err := a.renderTestConfig(config, sess, result); err != nil {
+		result.Error = config.ExecSecretValueMask(err.Error())
+		return result, nil
+	}
+
+	if r.Send {
+		resp, err := a.executor().execute(config, sess, identity.Name, r.Channel)
+		if err != nil {
+			result.Error = config.ExecSecretValueMask(err.Error())
+			return result, nil
+		}
+		result.StatusCode = int32(resp.StatusCode)
+		result.ResponseBody = config.ExecSecretValueMask(string(resp.Body))
+		response, err := config.ResponseCompile(sess, resp)
+		if err != nil {
+			result.Error = config.ExecSecretValueMask(err.Error())
+			return result, nil
+		}
+		result.Response = response
+	}
+	return result, nil
+}
+
+// renderTestConfig sets rendered templates to result, secrets are masked.
+func (a *AdminAPI) renderTestConfig(config *domain.Config, sess *domain.SessionValue, result *proto.TestConfigResponse) error {
+	text, err := config.TextCompile(sess)
+	if err != nil {
+		return err
+	}
+	result.Text = text
+
+	url, err := config.URLCompile(sess)
+	if err != nil {
+		return err
+	}
+	result.URL = config.ExecSecretValueMask(url)
+
+	body, err := config.BodyCompile(sess)
+	if err != nil {
+		return err
+	}
+	result.Body = config.ExecSecretValueMask(body)
+
+	headers, err := config.HeaderCompile(sess)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0)
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		result.Headers = append(result.Headers, &proto.Header{Key: k, Value: config.ExecSecretValueMask(headers[k])})
+	}
+	return nil
+}
+
+func (a *AdminAPI) executor() *executor {
+	return &executor{
+		AuditRepository:     a.AuditRepository,
+		ExecutionRepository: a.ExecutionRepository,
+		Log:                 a.Log,
+	}
+}
+
 // Mapper
+func (a *AdminAPI) executionToPbExecution(e *domain.Execution) *proto.Execution {
+	return &proto.Execution{
+		ID:         e.ID,
+		Time:       e.Time.Unix(),
+		SessionID:  e.SessionID,
+		ConfigID:   e.ConfigID,
+		User:       e.User,
+		Channel:    e.Channel,
+		Method:     e.Method,
+		URL:        e.URL,
+		Body:       e.Body,
+		StatusCode: int32(e.StatusCode),
+		LatencyMs:  int64(e.Latency / time.Millisecond),
+		Error:      e.Error,
+	}
+}
+
 func (a *AdminAPI) pbConfigToConfig(pbconfig *proto.Config) *domain.Config {
 	config := &domain.Config{
-		Title:              pbconfig.Title,
-		CallbackID:         pbconfig.ID,
-		Channels:           pbconfig.Channels,
-		RegexpString:       pbconfig.Regexp,
-		TextTemplateString: pbconfig.TextTemplate,
-		Actions:            pbconfig.Actions,
-		URLTemplateString:  pbconfig.URLTemplate,
-		BodyTemplateString: pbconfig.BodyTemplate,
-		Confirm:            pbconfig.Confirm,
-		Secrets:            make(map[string]string),
+		Title:                    pbconfig.Title,
+		CallbackID:               pbconfig.ID,
+		Channels:                 pbconfig.Channels,
+		RegexpString:             pbconfig.Regexp,
+		TextTemplateString:       pbconfig.TextTemplate,
+		Actions:                  pbconfig.Actions,
+		URLTemplateString:        pbconfig.URLTemplate,
+		BodyTemplateString:       pbconfig.BodyTemplate,
+		Confirm:                  pbconfig.Confirm,
+		Secrets:                  make(map[string]string),
+		Method:                   pbconfig.Method,
+		ContentType:              pbconfig.ContentType,
+		Headers:                  make(map[string]string),
+		ResponseTemplateString:   pbconfig.ResponseTemplate,
+		RetryMaxAttempts:         int(pbconfig.RetryMaxAttempts),
+		RetryBackoffString:       pbconfig.RetryBackoff,
+		TimeoutString:            pbconfig.Timeout,
+		Team:                     pbconfig.Team,
+		Command:                  pbconfig.Command,
+		Reaction:                 pbconfig.Reaction,
+		ScheduleString:           pbconfig.Schedule,
+		OptionsURLTemplateString: pbconfig.OptionsURL,
+		Approvers:                pbconfig.Approvers,
+		RequiredApprovals:        int(pbconfig.RequiredApprovals),
+		DenySelfApproval:         pbconfig.DenySelfApproval,
+		AllowedUsers:             pbconfig.AllowedUsers,
+		DeniedUsers:              pbconfig.DeniedUsers,
+		Priority:                 int(pbconfig.Priority),
+		MatchMode:                pbconfig.MatchMode,
+		ReplyInThread:            pbconfig.ReplyInThread,
+		ThreadMatch:              pbconfig.ThreadMatch,
+		StatusURLTemplateString:  pbconfig.StatusURL,
+		StatusIntervalString:     pbconfig.StatusInterval,
+		StatusTimeoutString:      pbconfig.StatusTimeout,
+		StatusSuccessString:      pbconfig.StatusSuccess,
+		StatusFailureString:      pbconfig.StatusFailure,
+		StatusPendingString:      pbconfig.StatusPending,
+	}
+
+	for _, code := range pbconfig.RetryStatusCodes {
+		config.RetryStatusCodes = append(config.RetryStatusCodes, int(code))
 	}
 
 	for _, s := range pbconfig.Secrets {
 		config.Secrets[s.Key] = s.Value
 	}
+	for _, step := range pbconfig.Steps {
+		config.Steps = append(config.Steps, domain.Step{
+			Name:    step.Name,
+			Text:    step.Text,
+			Options: step.Options,
+		})
+	}
+	for _, h := range pbconfig.Headers {
+		config.Headers[h.Key] = h.Value
+	}
 
 	return config
 }
 
 func (a *AdminAPI) configToPbConfig(config *domain.Config) *proto.Config {
 	pbconfig := &proto.Config{
-		Title:        config.Title,
-		ID:           config.CallbackID,
-		Channels:     config.Channels,
-		Regexp:       config.RegexpString,
-		TextTemplate: config.TextTemplateString,
-		Actions:      config.Actions,
-		URLTemplate:  config.URLTemplateString,
-		BodyTemplate: config.BodyTemplateString,
-		Confirm:      config.Confirm,
-		Secrets:      make([]*proto.Secret, 0),
+		Title:             config.Title,
+		ID:                config.CallbackID,
+		Channels:          config.Channels,
+		Regexp:            config.RegexpString,
+		TextTemplate:      config.TextTemplateString,
+		Actions:           config.Actions,
+		URLTemplate:       config.URLTemplateString,
+		BodyTemplate:      config.BodyTemplateString,
+		Confirm:           config.Confirm,
+		Secrets:           make([]*proto.Secret, 0),
+		Method:            config.Method,
+		ContentType:       config.ContentType,
+		Headers:           make([]*proto.Header, 0),
+		ResponseTemplate:  config.ResponseTemplateString,
+		RetryMaxAttempts:  int32(config.RetryMaxAttempts),
+		RetryBackoff:      config.RetryBackoffString,
+		Timeout:           config.TimeoutString,
+		Team:              config.Team,
+		Command:           config.Command,
+		Reaction:          config.Reaction,
+		Schedule:          config.ScheduleString,
+		OptionsURL:        config.OptionsURLTemplateString,
+		Approvers:         config.Approvers,
+		RequiredApprovals: int32(config.RequiredApprovals),
+		DenySelfApproval:  config.DenySelfApproval,
+		AllowedUsers:      config.AllowedUsers,
+		DeniedUsers:       config.DeniedUsers,
+		Priority:          int32(config.Priority),
+		MatchMode:         config.MatchMode,
+		ReplyInThread:     config.ReplyInThread,
+		ThreadMatch:       config.ThreadMatch,
+		StatusURL:         config.StatusURLTemplateString,
+		StatusInterval:    config.StatusIntervalString,
+		StatusTimeout:     config.StatusTimeoutString,
+		StatusSuccess:     config.StatusSuccessString,
+		StatusFailure:     config.StatusFailureString,
+		StatusPending:     config.StatusPendingString,
+	}
+
+	for _, code := range config.RetryStatusCodes {
+		pbconfig.RetryStatusCodes = append(pbconfig.RetryStatusCodes, int32(code))
 	}
 
 	for k, v := range config.Secrets {
 		pbconfig.Secrets = append(pbconfig.Secrets, &proto.Secret{Key: k, Value: v})
 	}
+	for _, step := range config.Steps {
+		pbconfig.Steps = append(pbconfig.Steps, &proto.Step{
+			Name:    step.Name,
+			Text:    step.Text,
+			Options: step.Options,
+		})
+	}
+	for k, v := range config.Headers {
+		pbconfig.Headers = append(pbconfig.Headers, &proto.Header{Key: k, Value: v})
+	}
 	return pbconfig
 }
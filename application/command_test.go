@@ -0,0 +1,147 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/juntaki/firestarter/domain"
+	"go.uber.org/zap"
+)
+
+func TestSlackBot_handleCommand(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"42"}`))
+	}))
+	defer target.Close()
+
+	replies := make(chan *responseURLMessage, 1)
+	responseURL := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		msg := &responseURLMessage{}
+		json.NewDecoder(r.Body).Decode(msg)
+		replies <- msg
+	}))
+	defer responseURL.Close()
+
+	deploy := &domain.Config{
+		CallbackID:             "deploy",
+		Channels:               []string{"general"},
+		Command:                "/deploy",
+		TextTemplateString:     "deploy {{index .matched 1}} to {{index .matched 2}}",
+		RegexpString:           `^(\S+) (\S+)$`,
+		URLTemplateString:      target.URL,
+		ResponseTemplateString: "job {{.body.id}}",
+	}
+	deploy.Hydrate()
+	release := &domain.Config{
+		CallbackID:         "release",
+		Channels:           []string{"general"},
+		Command:            "/release",
+		TextTemplateString: "release",
+		RegexpString:       `^(\S+)$`,
+		Actions:            []string{"yes"},
+		URLTemplateString:  target.URL,
+	}
+	release.Hydrate()
+	restricted := &domain.Config{
+		CallbackID:         "restricted",
+		Channels:           []string{"general"},
+		Command:            "/restricted",
+		TextTemplateString: "restricted",
+		RegexpString:       `^$`,
+		AllowedUsers:       []string{"U1"},
+		URLTemplateString:  target.URL,
+	}
+	restricted.Hydrate()
+
+	s := &SlackBot{
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				return domain.ConfigMap{"deploy": deploy, "release": release, "restricted": restricted}, nil
+			},
+		},
+		AuditRepository:     &DummyAuditRepository{},
+		ExecutionRepository: &DummyExecutionRepository{},
+		Log:                 zap.NewNop().Sugar(),
+		Session:             NewSession(&DummySessionStore{sessions: map[string]*domain.SessionValue{}}),
+	}
+
+	tests := []struct {
+		name             string
+		cmd              *slashCommand
+		wantResponseType string
+		wantText         string
+		wantAttachment   string
+	}{
+		{
+			name:             "non interactive",
+			cmd:              &slashCommand{Command: "/deploy", Text: "api staging", ChannelName: "general"},
+			wantResponseType: responseTypeInChannel,
+			wantText:         "deploy api to staging",
+			wantAttachment:   "job 42",
+		},
+		{
+			name:             "interactive",
+			cmd:              &slashCommand{Command: "/release", Text: "v1", ChannelName: "general"},
+			wantResponseType: responseTypeInChannel,
+			wantText:         "release",
+			wantAttachment:   "Select your choice",
+		},
+		{
+			name:             "usage",
+			cmd:              &slashCommand{Command: "/deploy", Text: "api", ChannelName: "general"},
+			wantResponseType: responseTypeEphemeral,
+			wantText:         `:x: Usage: /deploy ^(\S+) (\S+)$`,
+		},
+		{
+			name:             "allowed user",
+			cmd:              &slashCommand{Command: "/restricted", ChannelName: "general", UserID: "U1"},
+			wantResponseType: responseTypeInChannel,
+			wantText:         "restricted",
+		},
+		{
+			name:             "not allowed user",
+			cmd:              &slashCommand{Command: "/restricted", ChannelName: "general", UserID: "U2"},
+			wantResponseType: responseTypeEphemeral,
+			wantText:         ":no_entry: You are not allowed to trigger restricted",
+		},
+		{
+			name:             "other channel",
+			cmd:              &slashCommand{Command: "/deploy", Text: "api staging", ChannelName: "random"},
+			wantResponseType: responseTypeEphemeral,
+			wantText:         ":x: /deploy is not available in this channel",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cmd.ResponseURL = responseURL.URL
+			if err := s.handleCommand(tt.cmd); err != nil {
+				t.Fatalf("SlackBot.handleCommand() error = %v", err)
+			}
+			got := <-replies
+			attachment := ""
+			if len(got.Attachments) > 0 {
+				attachment = got.Attachments[0].Text
+			}
+			if got.ResponseType != tt.wantResponseType || got.Text != tt.wantText || attachment != tt.wantAttachment {
+				t.Errorf("SlackBot.handleCommand() reply = %q %q %q, want %q %q %q",
+					got.ResponseType, got.Text, attachment, tt.wantResponseType, tt.wantText, tt.wantAttachment)
+			}
+		})
+	}
+}
+
+func TestSlackBot_CommandHandler(t *testing.T) {
+	s := &SlackBot{VerificationToken: "token", Log: zap.NewNop().Sugar()}
+
+	form := url.Values{"token": {"invalid"}, "command": {"/deploy"}}
+	req := httptest.NewRequest("POST", "/commands", strings.NewReader(form.Encode()))
+	w := httptest.NewRecorder()
+	s.CommandHandler(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("SlackBot.CommandHandler() = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,179 @@
+package application
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nlopes/slack"
+	"go.uber.org/zap"
+)
+
+const (
+	eventsURLVerification = "url_verification"
+	eventsCallback        = "event_callback"
+	eventsMessage         = "message"
+	eventsReactionAdded   = "reaction_added"
+)
+
+const (
+	// Slack rejects requests older than this, to prevent replay attacks.
+	signatureMaxAge = 5 * time.Minute
+	// Slack retries the event for a while, keep event IDs longer than that.
+	eventDedupeTTL = time.Hour
+)
+
+// eventsAPIEnvelope is the outer event of Events API.
+type eventsAPIEnvelope struct {
+	Token     string          `json:"token"`
+	Type      string          `json:"type"`
+	Challenge string          `json:"challenge"`
+	EventID   string          `json:"event_id"`
+	Event     json.RawMessage `json:"event"`
+}
+
+type eventsAPIInnerEvent struct {
+	Type string `json:"type"`
+}
+
+// eventDeduper remembers seen event IDs, Slack may deliver the same event again.
+type eventDeduper struct {
+	mutex *sync.Mutex
+	seen  map[string]time.Time
+}
+
+func newEventDeduper() *eventDeduper {
+	return &eventDeduper{
+		mutex: &sync.Mutex{},
+		seen:  make(map[string]time.Time),
+	}
+}
+
+// IsDuplicated returns true if the ID is already seen, and marks it as seen.
+func (d *eventDeduper) IsDuplicated(ID string) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := time.Now()
+	for k, t := range d.seen {
+		if now.Sub(t) > eventDedupeTTL {
+			delete(d.seen, k)
+		}
+	}
+	if _, ok := d.seen[ID]; ok {
+		return true
+	}
+	d.seen[ID] = now
+	return false
+}
+
+// verifyRequest checks X-Slack-Signature if signing secret is set,
+// otherwise falls back to the verification token in the payload.
+func (s *SlackBot) verifyRequest(header http.Header, body []byte, token string) bool {
+	if s.SigningSecret == "" {
+		return token == s.VerificationToken
+	}
+
+	timestamp := header.Get("X-Slack-Request-Timestamp")
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	age := time.Since(time.Unix(ts, 0))
+	if age > signatureMaxAge || age < -signatureMaxAge {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.SigningSecret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(header.Get("X-Slack-Signature")))
+}
+
+// EventsHandler receives Events API request, message events are processed as RTM.
+func (s *SlackBot) EventsHandler(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		s.Log.Errorf("Failed to read request body: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var envelope eventsAPIEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		s.Log.Errorf("Failed to decode json event from slack: %s", body)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !s.verifyRequest(r.Header, body, envelope.Token) {
+		s.Log.Error("Invalid request signature or token")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch envelope.Type {
+	case eventsURLVerification:
+		w.Header().Add("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(envelope.Challenge))
+		return
+	case eventsCallback:
+		// Respond immediately, Slack retries if it takes over 3 seconds.
+		w.WriteHeader(http.StatusOK)
+		go s.handleEventCallback(&envelope, r.Header.Get("X-Slack-Retry-Num"))
+		return
+	default:
+		s.Log.Errorf("Invalid event type: %s", envelope.Type)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+}
+
+// handleEventCallback processes the verified event, events are processed as RTM.
+func (s *SlackBot) handleEventCallback(envelope *eventsAPIEnvelope, retry string) {
+	if s.eventDeduper.IsDuplicated(envelope.EventID) {
+		s.Log.Infow("Duplicated event", zap.String("eventID", envelope.EventID), zap.String("retry", retry))
+		return
+	}
+
+	var inner eventsAPIInnerEvent
+	if err := json.Unmarshal(envelope.Event, &inner); err != nil {
+		s.Log.Errorw("Failed to decode inner event", zap.Error(err))
+		return
+	}
+	switch inner.Type {
+	case eventsMessage:
+		var msg slack.Msg
+		if err := json.Unmarshal(envelope.Event, &msg); err != nil {
+			s.Log.Errorw("Failed to decode message event", zap.Error(err))
+			return
+		}
+		botID, err := s.getBotID()
+		if err != nil {
+			s.Log.Errorw("Get bot ID failed", zap.Error(err))
+			return
+		}
+		if err := s.handleMessage(&msg, botID); err != nil {
+			s.Log.Errorw("Handle message failed", zap.Error(err))
+		}
+	case eventsReactionAdded:
+		var ev slack.ReactionAddedEvent
+		if err := json.Unmarshal(envelope.Event, &ev); err != nil {
+			s.Log.Errorw("Failed to decode reaction event", zap.Error(err))
+			return
+		}
+		if err := s.handleReaction(&ev); err != nil {
+			s.Log.Errorw("Handle reaction failed", zap.Error(err))
+		}
+	default:
+		s.Log.Debugw("Ignore event", zap.String("type", inner.Type))
+	}
+}
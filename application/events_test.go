@@ -0,0 +1,124 @@
+package application
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func sign(secret, timestamp, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":" + body))
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestSlackBot_verifyRequest(t *testing.T) {
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	old := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+	body := `{"type":"url_verification"}`
+
+	tests := []struct {
+		name          string
+		signingSecret string
+		timestamp     string
+		signature     string
+		token         string
+		want          bool
+	}{
+		{"valid signature", "secret", now, sign("secret", now, body), "", true},
+		{"wrong secret", "secret", now, sign("other", now, body), "", false},
+		{"too old", "secret", old, sign("secret", old, body), "", false},
+		{"no signature", "secret", now, "", "token", false},
+		{"valid token", "", "", "", "token", true},
+		{"wrong token", "", "", "", "other", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &SlackBot{VerificationToken: "token", SigningSecret: tt.signingSecret}
+			header := http.Header{}
+			header.Set("X-Slack-Request-Timestamp", tt.timestamp)
+			header.Set("X-Slack-Signature", tt.signature)
+			if got := s.verifyRequest(header, []byte(body), tt.token); got != tt.want {
+				t.Errorf("SlackBot.verifyRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlackBot_EventsHandler(t *testing.T) {
+	s := &SlackBot{
+		SigningSecret: "secret",
+		Log:           zap.NewNop().Sugar(),
+		eventDeduper:  newEventDeduper(),
+	}
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	tests := []struct {
+		name     string
+		body     string
+		sign     bool
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "url verification",
+			body:     `{"type":"url_verification","challenge":"3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P"}`,
+			sign:     true,
+			wantCode: http.StatusOK,
+			wantBody: "3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P",
+		},
+		{
+			name:     "invalid signature",
+			body:     `{"type":"url_verification","challenge":"challenge"}`,
+			sign:     false,
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "ignored event",
+			body:     `{"type":"event_callback","event_id":"Ev1","event":{"type":"reaction_added"}}`,
+			sign:     true,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "unknown type",
+			body:     `{"type":"unknown"}`,
+			sign:     true,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/events", strings.NewReader(tt.body))
+			req.Header.Set("X-Slack-Request-Timestamp", now)
+			if tt.sign {
+				req.Header.Set("X-Slack-Signature", sign("secret", now, tt.body))
+			}
+			w := httptest.NewRecorder()
+			s.EventsHandler(w, req)
+			if w.Code != tt.wantCode || w.Body.String() != tt.wantBody {
+				t.Errorf("SlackBot.EventsHandler() = %d %q, want %d %q", w.Code, w.Body.String(), tt.wantCode, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestEventDeduper_IsDuplicated(t *testing.T) {
+	d := newEventDeduper()
+	if d.IsDuplicated("Ev1") {
+		t.Errorf("eventDeduper.IsDuplicated() first event is duplicated")
+	}
+	if !d.IsDuplicated("Ev1") {
+		t.Errorf("eventDeduper.IsDuplicated() retried event is not duplicated")
+	}
+	if d.IsDuplicated("Ev2") {
+		t.Errorf("eventDeduper.IsDuplicated() other event is duplicated")
+	}
+}
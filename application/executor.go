@@ -0,0 +1,148 @@
+package application
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/juntaki/firestarter/domain"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const maxResponseBody = 1 << 20 // 1MiB
+
+// executor sends the outgoing request of the config, shared by bot and admin.
+type executor struct {
+	AuditRepository     domain.AuditRepository
+	ExecutionRepository domain.ExecutionRepository
+	Log                 *zap.SugaredLogger
+}
+
+// execute sends the request, and records the execution and who triggered it.
+func (e *executor) execute(c *domain.Config, sess *domain.SessionValue, user, channel string) (*domain.Response, error) {
+	execution := domain.NewExecution(c, sess, user, channel)
+	start := time.Now()
+	resp, err := e.sendRequest(c, sess, execution)
+	execution.Latency = time.Since(start)
+	execution.SetResult(c, sess, err)
+	if err := e.ExecutionRepository.AddExecution(execution); err != nil {
+		e.Log.Errorw("Record execution failed", zap.Error(err), zap.String("id", c.CallbackID))
+	}
+
+	event := domain.NewAuditEvent(user, domain.AuditActionTrigger, c.CallbackID)
+	event.Detail = fmt.Sprintf("session: %s, value: %s, execution: %s", sess.ID, sess.Value, execution.ID)
+	if len(sess.Approvals) > 0 {
+		event.Detail += ", approvals: " + strings.Join(sess.Approvals, ",")
+	}
+	event.SetOutcome(err)
+	if err := e.AuditRepository.AddAuditEvent(event); err != nil {
+		e.Log.Errorw("Audit failed", zap.Error(err), zap.String("action", event.Action))
+	}
+	return resp, err
+}
+
+// sendRequest sends the request, the rendered request and the status code are set to execution.
+func (e *executor) sendRequest(c *domain.Config, sess *domain.SessionValue, execution *domain.Execution) (*domain.Response, error) {
+	url, err := c.URLCompile(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.BodyCompile(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := c.HeaderCompile(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	method := c.Method
+	if method == "" {
+		method = domain.DefaultMethod
+	}
+	contentType := c.ContentType
+	if contentType == "" {
+		contentType = domain.DefaultContentType
+	}
+
+	execution.Method = method
+	execution.URL = url
+	execution.Body = body
+
+	e.Log.Infow("Send Request",
+		zap.String("method", method),
+		zap.String("url", url),
+		zap.String("body", body),
+	)
+
+	attempts := c.RetryMaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	backoff := c.RetryBackoff
+	client := &http.Client{Timeout: c.Timeout}
+
+	var resp *http.Response
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequest(
+			method,
+			url,
+			bytes.NewBuffer([]byte(body)),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "Cannot make request")
+		}
+
+		req.Header.Set("Content-Type", contentType)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+
+		resp, err = client.Do(req)
+		if err != nil {
+			e.Log.Infow("Send request attempt failed",
+				zap.Int("attempt", attempt),
+				zap.Error(err),
+			)
+			if attempt >= attempts {
+				return nil, errors.Errorf("%s request failed", method)
+			}
+		} else {
+			e.Log.Infow("Send request attempt",
+				zap.Int("attempt", attempt),
+				zap.Int("status", resp.StatusCode),
+			)
+			if attempt >= attempts || !c.IsRetryStatus(resp.StatusCode) {
+				break
+			}
+			resp.Body.Close()
+		}
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	defer resp.Body.Close()
+	execution.StatusCode = resp.StatusCode
+	if !(resp.StatusCode >= 200 && resp.StatusCode <= 299) {
+		e.Log.Infof("Send request failed status: %d", resp.StatusCode)
+		return nil, errors.Errorf("Send request failed status: %d", resp.StatusCode)
+	}
+
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to read response body")
+	}
+	e.Log.Info("Send request success")
+	return &domain.Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       respBody,
+	}, nil
+}
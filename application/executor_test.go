@@ -0,0 +1,191 @@
+package application
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/juntaki/firestarter/domain"
+	"go.uber.org/zap"
+)
+
+func TestExecutor_sendRequest(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit := atomic.AddInt32(&hits, 1)
+		switch r.URL.Path {
+		case "/flaky":
+			if hit == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.Write([]byte("ok"))
+		case "/down":
+			w.WriteHeader(http.StatusBadGateway)
+		case "/error":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/slow":
+			time.Sleep(100 * time.Millisecond)
+			w.Write([]byte("ok"))
+		case "/large":
+			w.Write([]byte(strings.Repeat("a", maxResponseBody+1)))
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		path        string
+		maxAttempts int
+		timeout     string
+		wantErr     bool
+		wantStatus  int
+		wantHits    int32
+		wantBodyLen int
+	}{
+		{name: "502 then 200", path: "/flaky", maxAttempts: 3, wantStatus: 200, wantHits: 2, wantBodyLen: 2},
+		{name: "retries run out", path: "/down", maxAttempts: 3, wantErr: true, wantStatus: 502, wantHits: 3},
+		{name: "no retry by default", path: "/down", wantErr: true, wantStatus: 502, wantHits: 1},
+		{name: "not retried status", path: "/error", maxAttempts: 3, wantErr: true, wantStatus: 500, wantHits: 1},
+		{name: "timeout", path: "/slow", maxAttempts: 2, timeout: "10ms", wantErr: true, wantHits: 2},
+		{name: "large body", path: "/large", wantStatus: 200, wantHits: 1, wantBodyLen: maxResponseBody},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &domain.Config{
+				CallbackID:         "deploy",
+				URLTemplateString:  server.URL + tt.path,
+				RetryMaxAttempts:   tt.maxAttempts,
+				RetryBackoffString: "1ms",
+				TimeoutString:      tt.timeout,
+			}
+			c.Hydrate()
+			e := &executor{Log: zap.NewNop().Sugar()}
+			execution := &domain.Execution{}
+
+			atomic.StoreInt32(&hits, 0)
+			resp, err := e.sendRequest(c, &domain.SessionValue{}, execution)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("executor.sendRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if execution.StatusCode != tt.wantStatus {
+				t.Errorf("executor.sendRequest() status = %d, want %d", execution.StatusCode, tt.wantStatus)
+			}
+			if got := atomic.LoadInt32(&hits); got != tt.wantHits {
+				t.Errorf("executor.sendRequest() requested %d times, want %d", got, tt.wantHits)
+			}
+			if err == nil && len(resp.Body) != tt.wantBodyLen {
+				t.Errorf("executor.sendRequest() body length = %d, want %d", len(resp.Body), tt.wantBodyLen)
+			}
+		})
+	}
+}
+
+func TestExecutor_sendRequest_method(t *testing.T) {
+	requests := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- r.Method + " " + r.Header.Get("Content-Type") + " " + string(body)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		body        string
+		want        string
+	}{
+		{name: "default", body: `{"ref":"master"}`, want: `POST application/json {"ref":"master"}`},
+		{name: "GET", method: "GET", want: "GET application/json "},
+		{name: "PUT", method: "PUT", body: `{"ref":"master"}`, want: `PUT application/json {"ref":"master"}`},
+		{name: "DELETE", method: "DELETE", want: "DELETE application/json "},
+		{
+			name:        "form",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "ref=master&token={{.secrets.TOKEN}}",
+			want:        "POST application/x-www-form-urlencoded ref=master&token=secret",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &domain.Config{
+				CallbackID:         "deploy",
+				URLTemplateString:  server.URL,
+				BodyTemplateString: tt.body,
+				Method:             tt.method,
+				ContentType:        tt.contentType,
+				Secrets:            map[string]string{"TOKEN": "secret"},
+			}
+			c.Hydrate()
+			e := &executor{Log: zap.NewNop().Sugar()}
+			if _, err := e.sendRequest(c, &domain.SessionValue{}, &domain.Execution{}); err != nil {
+				t.Fatal(err)
+			}
+			if got := <-requests; got != tt.want {
+				t.Errorf("executor.sendRequest() sent %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecutor_execute_secrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		url       string
+		wantURL   string
+		wantError string
+	}{
+		{
+			name:    "sent",
+			url:     server.URL + "/?t={{urlquery .secrets.TOKEN}}",
+			wantURL: server.URL + "/?t=%3CSecretValue%3E",
+		},
+		{
+			name:      "invalid URL",
+			url:       "http://localhost:%zz/{{pathescape .secrets.TOKEN}}",
+			wantError: "URL parse failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &domain.Config{
+				CallbackID:         "deploy",
+				URLTemplateString:  tt.url,
+				BodyTemplateString: `{"token":{{json .secrets.TOKEN}},"basic":"{{b64enc .secrets.TOKEN}}"}`,
+				Secrets:            map[string]string{"TOKEN": "a+b/c="},
+			}
+			c.Hydrate()
+			executions := &DummyExecutionRepository{}
+			e := &executor{
+				AuditRepository:     &DummyAuditRepository{},
+				ExecutionRepository: executions,
+				Log:                 zap.NewNop().Sugar(),
+			}
+			e.execute(c, &domain.SessionValue{}, "alice", "C1")
+
+			got := executions.executions[0]
+			if got.URL != tt.wantURL {
+				t.Errorf("execution URL = %q, want %q", got.URL, tt.wantURL)
+			}
+			if want := `{"token":"\u003cSecretValue\u003e","basic":"PFNlY3JldFZhbHVlPg=="}`; got.Body != want {
+				t.Errorf("execution body = %q, want %q", got.Body, want)
+			}
+			if !strings.HasPrefix(got.Error, tt.wantError) || (tt.wantError == "") != (got.Error == "") {
+				t.Errorf("execution error = %q, want %q", got.Error, tt.wantError)
+			}
+			for _, leaked := range []string{"a+b", "a%2Bb", "b%2Fc", "YStiL2M9"} {
+				if strings.Contains(got.URL+got.Body+got.Error, leaked) {
+					t.Errorf("execution has the secret %q: %v", leaked, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package application
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/juntaki/firestarter/domain"
+	"github.com/nlopes/slack"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const maxStatusBody = 1 << 20 // 1MiB
+
+// job is the job started by the request, its status is polled by StatusURL.
+type job struct {
+	config      *domain.Config
+	sess        *domain.SessionValue
+	statusURL   string
+	text        string             // of the message to update
+	attachments []slack.Attachment // of the message to update, without the status
+	messages    []postedMessage
+	start       time.Time
+}
+
+// startJob returns the job to be tracked, and the attachments with its status.
+// The job is nil if the status URL can't be rendered, the error is shown instead.
+func (s *SlackBot) startJob(c *domain.Config, sess *domain.SessionValue, resp *domain.Response, text string, attachments []slack.Attachment) (*job, []slack.Attachment) {
+	j := &job{
+		config:      c,
+		sess:        sess,
+		text:        text,
+		attachments: append([]slack.Attachment{}, attachments...),
+		start:       time.Now(),
+	}
+	statusURL, err := c.StatusURLCompile(sess, resp)
+	if err != nil {
+		s.Log.Errorw("Compile status URL failed", zap.Error(err), zap.String("id", c.CallbackID))
+		return nil, j.withStatus(slack.Attachment{
+			Text:  ":warning: Status is not tracked, " + c.ExecSecretValueMask(err.Error()),
+			Color: "warning",
+		})
+	}
+	j.statusURL = statusURL
+	return j, j.withStatus(j.statusAttachment(domain.JobPending, 0))
+}
+
+// trackJob polls the status until the job ends or StatusTimeout, and updates the messages.
+func (s *SlackBot) trackJob(j *job) {
+	ticker := time.NewTicker(j.config.StatusInterval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		elapsed := now.Sub(j.start)
+		state, err := s.pollStatus(j)
+		if err != nil {
+			s.Log.Infow("Poll status failed", zap.Error(err), zap.String("id", j.config.CallbackID))
+		}
+		switch {
+		case err == nil && state != domain.JobPending:
+			s.Log.Infow("Job finished", zap.String("id", j.config.CallbackID),
+				zap.String("state", state), zap.Duration("elapsed", elapsed))
+			s.updateJob(j, j.statusAttachment(state, elapsed))
+			return
+		case elapsed >= j.config.StatusTimeout:
+			s.Log.Infow("Job timed out", zap.String("id", j.config.CallbackID), zap.Duration("elapsed", elapsed))
+			attachment := slack.Attachment{
+				Text:  fmt.Sprintf(":warning: Status is unknown, timed out after %s", roundSeconds(elapsed)),
+				Color: "warning",
+			}
+			if err != nil {
+				attachment.Text += ", " + j.config.ExecSecretValueMask(err.Error())
+			}
+			s.updateJob(j, attachment)
+			return
+		}
+		s.updateJob(j, j.statusAttachment(domain.JobPending, elapsed))
+	}
+}
+
+// pollStatus gets StatusURL, and returns the job state.
+// The headers of the config are sent only if StatusURL is on the host of the request.
+func (s *SlackBot) pollStatus(j *job) (string, error) {
+	headers, err := j.config.HeaderCompileFor(j.sess, j.statusURL)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest("GET", j.statusURL, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot make status request")
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	client := &http.Client{Timeout: j.config.Timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", errors.New("Status request failed")
+	}
+	defer resp.Body.Close()
+	if !(resp.StatusCode >= 200 && resp.StatusCode <= 299) {
+		return "", errors.Errorf("Status request failed status: %d", resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxStatusBody))
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to read status body")
+	}
+	return j.config.JobState(j.sess, &domain.Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+	})
+}
+
+// updateJob replaces the status of the messages by chat.update.
+func (s *SlackBot) updateJob(j *job, status slack.Attachment) {
+	for _, m := range j.messages {
+		_, _, _, err := s.API.SendMessage(
+			m.Channel,
+			slack.MsgOptionUpdate(m.Timestamp),
+			slack.MsgOptionText(j.text, false),
+			slack.MsgOptionAttachments(j.withStatus(status)...),
+		)
+		if err != nil {
+			s.Log.Errorw("Update job status failed", zap.Error(err), zap.String("channel", m.Channel))
+		}
+	}
+}
+
+// withStatus returns the attachments of the message, the status is the last.
+func (j *job) withStatus(status slack.Attachment) []slack.Attachment {
+	return append(append([]slack.Attachment{}, j.attachments...), status)
+}
+
+func (j *job) statusAttachment(state string, elapsed time.Duration) slack.Attachment {
+	switch state {
+	case domain.JobSuccess:
+		return slack.Attachment{Text: fmt.Sprintf(":white_check_mark: Succeeded in %s", roundSeconds(elapsed)), Color: "good"}
+	case domain.JobFailure:
+		return slack.Attachment{Text: fmt.Sprintf(":x: Failed in %s", roundSeconds(elapsed)), Color: "danger"}
+	}
+	return slack.Attachment{Text: fmt.Sprintf(":hourglass_flowing_sand: Running, %s elapsed", roundSeconds(elapsed)), Color: "#f9a41b"}
+}
+
+// roundSeconds returns the duration to show, in seconds.
+func roundSeconds(d time.Duration) time.Duration {
+	return d.Round(time.Second)
+}
@@ -0,0 +1,128 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/juntaki/firestarter/domain"
+	"github.com/nlopes/slack"
+	"go.uber.org/zap"
+)
+
+func TestSlackBot_trackJob(t *testing.T) {
+	var polls int32
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/deploy":
+			w.Write([]byte(`{"id":42}`))
+		case "/jobs/42":
+			if r.Header.Get("Authorization") != "Bearer secret" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			if atomic.AddInt32(&polls, 1) < 3 {
+				w.Write([]byte(`{"state":"running"}`))
+				return
+			}
+			w.Write([]byte(`{"state":"success"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer target.Close()
+
+	// Status attachment of each post and update.
+	statuses := make(chan string, 10)
+	slackAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		attachments := []slack.Attachment{}
+		json.Unmarshal([]byte(r.Form.Get("attachments")), &attachments)
+		if len(attachments) > 0 {
+			statuses <- r.URL.Path + " " + r.Form.Get("ts") + " " + attachments[len(attachments)-1].Text
+		}
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"1.0"}`))
+	}))
+	defer slackAPI.Close()
+
+	c := &domain.Config{
+		CallbackID:              "deploy",
+		Channels:                []string{"general"},
+		TextTemplateString:      "deploy",
+		RegexpString:            "^deploy$",
+		URLTemplateString:       target.URL + "/deploy",
+		Secrets:                 map[string]string{"TOKEN": "secret"},
+		Headers:                 map[string]string{"Authorization": "Bearer {{.secrets.TOKEN}}"},
+		StatusURLTemplateString: target.URL + "/jobs/{{.body.id}}",
+		StatusPendingString:     `{{eq .body.state "running"}}`,
+		StatusSuccessString:     `{{eq .body.state "success"}}`,
+		StatusFailureString:     `{{eq .body.state "failed"}}`,
+	}
+	c.Hydrate()
+	c.StatusInterval = 10 * time.Millisecond
+
+	s := &SlackBot{
+		API:                 slack.New("xoxb-token", slack.OptionAPIURL(slackAPI.URL+"/")),
+		AuditRepository:     &DummyAuditRepository{},
+		ExecutionRepository: &DummyExecutionRepository{},
+		Log:                 zap.NewNop().Sugar(),
+	}
+	replier := &channelReplier{API: s.API, Channel: "C1"}
+	if err := s.ProcessNonInteractiveRequest(c, &domain.SessionValue{}, replier, "C1", "alice"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"/chat.postMessage  :hourglass_flowing_sand: Running, 0s elapsed",
+		"/chat.update 1.0 :hourglass_flowing_sand: Running, 0s elapsed",
+		"/chat.update 1.0 :hourglass_flowing_sand: Running, 0s elapsed",
+		"/chat.update 1.0 :white_check_mark: Succeeded in 0s",
+	}
+	for i, w := range want {
+		select {
+		case got := <-statuses:
+			if got != w {
+				t.Errorf("status %d = %q, want %q", i, got, w)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("status %d is not updated, want %q", i, w)
+		}
+	}
+}
+
+func TestSlackBot_pollStatus_otherHost(t *testing.T) {
+	authorization := make(chan string, 1)
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization <- r.Header.Get("Authorization")
+		w.Write([]byte(`{"state":"success"}`))
+	}))
+	defer other.Close()
+
+	c := &domain.Config{
+		CallbackID:              "deploy",
+		URLTemplateString:       "https://ci.example.com/deploy",
+		Secrets:                 map[string]string{"TOKEN": "secret"},
+		Headers:                 map[string]string{"Authorization": "Bearer {{.secrets.TOKEN}}"},
+		StatusURLTemplateString: "{{.body.url}}",
+		StatusSuccessString:     `{{eq .body.state "success"}}`,
+	}
+	c.Hydrate()
+	s := &SlackBot{Log: zap.NewNop().Sugar()}
+	// The response of the request tells the status URL on another host.
+	resp := &domain.Response{StatusCode: 200, Body: []byte(`{"url":"` + other.URL + `/jobs/42"}`)}
+	j, _ := s.startJob(c, &domain.SessionValue{}, resp, "deploy", nil)
+	if j == nil {
+		t.Fatal("SlackBot.startJob() = nil")
+	}
+
+	state, err := s.pollStatus(j)
+	if err != nil || state != domain.JobSuccess {
+		t.Fatalf("SlackBot.pollStatus() = %q, %v, want %q", state, err, domain.JobSuccess)
+	}
+	if got := <-authorization; got != "" {
+		t.Errorf("SlackBot.pollStatus() sent Authorization %q to other host", got)
+	}
+}
@@ -0,0 +1,146 @@
+package application
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/juntaki/firestarter/domain"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	optionsCacheTTL = 1 * time.Minute
+	maxOptions      = 100     // Slack shows up to 100 options in a select.
+	maxOptionsBody  = 1 << 20 // 1MiB
+)
+
+// optionsCache keeps the options loaded from OptionsURL for a while, by rendered URL.
+type optionsCache struct {
+	mutex   *sync.Mutex
+	entries map[string]optionsCacheEntry
+}
+
+type optionsCacheEntry struct {
+	options []domain.Option
+	expire  time.Time
+}
+
+func newOptionsCache() *optionsCache {
+	return &optionsCache{
+		mutex:   &sync.Mutex{},
+		entries: make(map[string]optionsCacheEntry),
+	}
+}
+
+func (o *optionsCache) Get(key string) ([]domain.Option, bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	entry, ok := o.entries[key]
+	if !ok || time.Now().After(entry.expire) {
+		return nil, false
+	}
+	return entry.options, true
+}
+
+func (o *optionsCache) Set(key string, options []domain.Option) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	now := time.Now()
+	for k, entry := range o.entries {
+		if now.After(entry.expire) {
+			delete(o.entries, k)
+		}
+	}
+	o.entries[key] = optionsCacheEntry{
+		options: options,
+		expire:  now.Add(optionsCacheTTL),
+	}
+}
+
+// stepOptions returns the options of the current step.
+// Actions step loads them from OptionsURL if set, and falls back to Actions on error.
+func (s *SlackBot) stepOptions(c *domain.Config, sess *domain.SessionValue) ([]domain.Option, error) {
+	step := c.InteractiveSteps()[sess.Step]
+	if len(c.Steps) == 0 && c.OptionsURLTemplateString != "" {
+		options, err := s.loadOptions(c, sess)
+		if err == nil {
+			return options, nil
+		}
+		if len(step.Options) == 0 {
+			return nil, err
+		}
+		s.Log.Errorw("Load options failed, use Actions", zap.Error(err), zap.String("id", c.CallbackID))
+	}
+
+	options := []domain.Option{}
+	for _, a := range step.Options {
+		options = append(options, domain.Option{Label: a, Value: a})
+	}
+	return options, nil
+}
+
+// loadOptions gets the options from OptionsURL.
+// The headers of the config are sent only if OptionsURL is on the host of the request.
+func (s *SlackBot) loadOptions(c *domain.Config, sess *domain.SessionValue) ([]domain.Option, error) {
+	url, err := c.OptionsURLCompile(sess)
+	if err != nil {
+		return nil, err
+	}
+	key := c.CallbackID + "@" + url
+	if options, ok := s.optionsCache.Get(key); ok {
+		return options, nil
+	}
+
+	headers, err := c.HeaderCompileFor(sess, url)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot make options request")
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	client := &http.Client{Timeout: c.Timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.New("Options request failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("Options request failed status: %d", resp.StatusCode)
+	}
+
+	loaded := []domain.Option{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxOptionsBody)).Decode(&loaded); err != nil {
+		return nil, errors.Wrap(err, "Options are invalid JSON")
+	}
+	options := []domain.Option{}
+	for _, o := range loaded {
+		if o.Value == "" {
+			continue
+		}
+		if o.Label == "" {
+			o.Label = o.Value
+		}
+		options = append(options, o)
+	}
+	if len(options) == 0 {
+		return nil, errors.New("No options are loaded")
+	}
+	if len(options) > maxOptions {
+		options = options[:maxOptions]
+	}
+
+	s.optionsCache.Set(key, options)
+	return options, nil
+}
@@ -0,0 +1,105 @@
+package application
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/juntaki/firestarter/domain"
+	"go.uber.org/zap"
+)
+
+func TestSlackBot_stepOptions(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		switch r.URL.Path {
+		case "/versions", "/public":
+			if r.Header.Get("Authorization") != "Bearer secret" || r.URL.Query().Get("app") != "api" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Write([]byte(`[{"label":"v2 (latest)","value":"v2"},{"value":"v1"},{"label":"empty"}]`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		request  string
+		url      string
+		actions  []string
+		want     []domain.Option
+		wantErr  bool
+		wantHits int32
+	}{
+		{
+			name:    "loaded",
+			request: server.URL + "/deploy",
+			url:     server.URL + "/versions?app={{index .matched 1}}",
+			want: []domain.Option{
+				{Label: "v2 (latest)", Value: "v2"},
+				{Label: "v1", Value: "v1"},
+			},
+			wantHits: 1,
+		},
+		{
+			name:    "headers only to request host",
+			request: "https://ci.example.com/deploy",
+			url:     server.URL + "/public?app={{index .matched 1}}",
+			actions: []string{"master"},
+			want: []domain.Option{
+				{Label: "master", Value: "master"},
+			},
+			wantHits: 2,
+		},
+		{
+			name:    "fallback to actions",
+			url:     server.URL + "/error",
+			actions: []string{"master"},
+			want: []domain.Option{
+				{Label: "master", Value: "master"},
+			},
+			wantHits: 2,
+		},
+		{
+			name:     "error without actions",
+			url:      server.URL + "/error",
+			wantErr:  true,
+			wantHits: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &domain.Config{
+				CallbackID:               "deploy",
+				Actions:                  tt.actions,
+				URLTemplateString:        tt.request,
+				OptionsURLTemplateString: tt.url,
+				Secrets:                  map[string]string{"TOKEN": "secret"},
+				Headers:                  map[string]string{"Authorization": "Bearer {{.secrets.TOKEN}}"},
+			}
+			c.Hydrate()
+			s := &SlackBot{Log: zap.NewNop().Sugar(), optionsCache: newOptionsCache()}
+			sess := &domain.SessionValue{Matched: []string{"deploy api", "api"}}
+
+			atomic.StoreInt32(&hits, 0)
+			for i := 0; i < 2; i++ {
+				got, err := s.stepOptions(c, sess)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("SlackBot.stepOptions() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("SlackBot.stepOptions() = %v, want %v", got, tt.want)
+				}
+			}
+			if got := atomic.LoadInt32(&hits); got != tt.wantHits {
+				t.Errorf("SlackBot.stepOptions() requested %d times, want %d", got, tt.wantHits)
+			}
+		})
+	}
+}
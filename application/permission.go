@@ -0,0 +1,74 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/juntaki/firestarter/domain"
+	"github.com/nlopes/slack"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// isUserGroupMember asks the members of the user group to Slack.
+func (s *SlackBot) isUserGroupMember(group, user string) (bool, error) {
+	members, err := s.API.GetUserGroupMembers(group)
+	if err != nil {
+		return false, errors.Wrap(err, "Get user group members failed")
+	}
+	for _, member := range members {
+		if member == user {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isAllowed returns true if the user can trigger the config, false on error.
+func (s *SlackBot) isAllowed(c *domain.Config, user string) bool {
+	allowed, err := c.IsUserAllowed(user, s.isUserGroupMember)
+	if err != nil {
+		s.Log.Errorw("Check allowed users failed", zap.Error(err), zap.String("id", c.CallbackID))
+		return false
+	}
+	if !allowed {
+		s.Log.Infow("User is not allowed", zap.String("id", c.CallbackID), zap.String("user", user))
+	}
+	return allowed
+}
+
+// canCancel returns true if the user can cancel the request, the requester or approvers.
+// Before any selection or without Approvers, users who can trigger the config can cancel too.
+func (s *SlackBot) canCancel(c *domain.Config, sess *domain.SessionValue, user string) bool {
+	if sess.Requester != "" && sess.Requester == user {
+		return true
+	}
+	if len(c.Approvers) > 0 {
+		approver, err := c.IsApprover(user, s.isUserGroupMember)
+		if err != nil {
+			s.Log.Errorw("Check approvers failed", zap.Error(err), zap.String("id", c.CallbackID))
+			return false
+		}
+		if approver {
+			return true
+		}
+		if sess.Requester != "" {
+			return false
+		}
+	}
+	return s.isAllowed(c, user)
+}
+
+// notAllowedText tells the user why the config didn't start.
+func notAllowedText(c *domain.Config) string {
+	return fmt.Sprintf(":no_entry: You are not allowed to trigger %s", c.Title)
+}
+
+// replyEphemeral posts the message only visible to the user.
+func (s *SlackBot) replyEphemeral(channel, user, text string) {
+	if user == "" {
+		return
+	}
+	if _, err := s.API.PostEphemeral(channel, user, slack.MsgOptionText(text, false)); err != nil {
+		s.Log.Errorw("Post ephemeral failed", zap.Error(err))
+	}
+}
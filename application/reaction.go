@@ -0,0 +1,96 @@
+package application
+
+import (
+	"github.com/nlopes/slack"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const reactionItemMessage = "message"
+
+// handleReaction finds the config triggered by the reaction, matched groups come from the reacted message.
+func (s *SlackBot) handleReaction(ev *slack.ReactionAddedEvent) error {
+	if ev.Item.Type != reactionItemMessage {
+		return nil
+	}
+
+	// Get config on each event, it may be updated.
+	config, err := s.ConfigRepository.GetConfigList()
+	if err != nil {
+		return err
+	}
+	// Most reactions trigger nothing, skip API calls for them.
+	if !config.HasReaction(ev.Reaction) {
+		return nil
+	}
+
+	name, err := s.getChannelName(ev.Item.Channel)
+	if err != nil {
+		return err
+	}
+
+	msg, err := s.getMessage(ev.Item.Channel, ev.Item.Timestamp)
+	if err != nil {
+		return errors.Wrap(err, "get reacted message")
+	}
+	message := messageText(msg)
+
+	c := config.FindByReaction(ev.Reaction, name, message)
+	if c == nil {
+		return nil
+	}
+	s.Log.Infow("Reaction Match", zap.String("id", c.CallbackID),
+		zap.String("reaction", ev.Reaction),
+		zap.String("message", message),
+	)
+	if !s.isAllowed(c, ev.User) {
+		s.replyEphemeral(ev.Item.Channel, ev.User, notAllowedText(c))
+		return nil
+	}
+
+	// Create Session for matched request
+	msgContext := s.messageContext(ev.Item.Channel, name, ev.User, msg)
+	sess, err := s.Session.Create(c.Regexp.FindStringSubmatch(message), msgContext)
+	if err != nil {
+		return errors.Wrap(err, "create session")
+	}
+	s.Log.Infow("Create Session", zap.String("SessionID", sess.ID))
+
+	replier := &channelReplier{API: s.API, Channel: ev.Item.Channel, ThreadTS: c.ReplyThreadTS(msgContext)}
+	return s.startRequest(c, sess, replier, ev.Item.Channel, msgContext.UserName)
+}
+
+// getMessage returns the message at the timestamp in the channel.
+// Thread replies are not in the history, they are looked up by conversations.replies.
+func (s *SlackBot) getMessage(channelID, timestamp string) (*slack.Msg, error) {
+	history, err := s.API.GetConversationHistory(&slack.GetConversationHistoryParameters{
+		ChannelID: channelID,
+		Latest:    timestamp,
+		Inclusive: true,
+		Limit:     1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(history.Messages) > 0 && history.Messages[0].Timestamp == timestamp {
+		return &history.Messages[0].Msg, nil
+	}
+
+	replies, _, _, err := s.API.GetConversationReplies(&slack.GetConversationRepliesParameters{
+		ChannelID: channelID,
+		Timestamp: timestamp,
+		Latest:    timestamp,
+		Oldest:    timestamp,
+		Inclusive: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The parent message comes first, even if it's out of the range.
+	for _, reply := range replies {
+		if reply.Timestamp == timestamp {
+			return &reply.Msg, nil
+		}
+	}
+	return nil, errors.New("Message not found")
+}
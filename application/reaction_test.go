@@ -0,0 +1,109 @@
+package application
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/juntaki/firestarter/domain"
+	"github.com/nlopes/slack"
+	"go.uber.org/zap"
+)
+
+func TestSlackBot_handleReaction(t *testing.T) {
+	calls := make(chan string, 10)
+	slackAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		calls <- r.URL.Path
+		switch r.URL.Path {
+		case "/conversations.history":
+			// The latest top-level message, thread replies are not in the history.
+			w.Write([]byte(`{"ok":true,"messages":[{"type":"message","ts":"1.0","text":"deploy api"}]}`))
+		case "/conversations.replies":
+			w.Write([]byte(`{"ok":true,"messages":[` +
+				`{"type":"message","ts":"1.0","thread_ts":"1.0","text":"deploy api"},` +
+				`{"type":"message","ts":"2.0","thread_ts":"1.0","text":"deploy web"}]}`))
+		case "/chat.postMessage":
+			calls <- "text:" + r.Form.Get("text")
+			w.Write([]byte(`{"ok":true,"channel":"C1","ts":"3.0"}`))
+		default:
+			w.Write([]byte(`{"ok":false,"error":"not_found"}`))
+		}
+	}))
+	defer slackAPI.Close()
+
+	deploy := &domain.Config{
+		CallbackID:         "deploy",
+		Channels:           []string{"general"},
+		TextTemplateString: "deploy {{index .matched 1}}",
+		RegexpString:       "^deploy (.+)$",
+		Reaction:           "rocket",
+		Actions:            []string{"master"},
+		URLTemplateString:  "http://localhost",
+	}
+	deploy.Hydrate()
+	s := &SlackBot{
+		API: slack.New("xoxb-token", slack.OptionAPIURL(slackAPI.URL+"/")),
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				return domain.ConfigMap{"deploy": deploy}, nil
+			},
+		},
+		Log:          zap.NewNop().Sugar(),
+		Session:      NewSession(&DummySessionStore{sessions: map[string]*domain.SessionValue{}}),
+		channelCache: map[string]string{"C1": "general"},
+	}
+
+	tests := []struct {
+		name      string
+		reaction  string
+		timestamp string
+		wantCalls []string
+		wantErr   bool
+	}{
+		{
+			name:      "unmatched reaction",
+			reaction:  "eyes",
+			timestamp: "1.0",
+			wantCalls: []string{},
+		},
+		{
+			name:      "top-level message",
+			reaction:  "rocket",
+			timestamp: "1.0",
+			wantCalls: []string{"/conversations.history", "/users.info", "/chat.getPermalink", "/chat.postMessage", "text:deploy api"},
+		},
+		{
+			name:      "thread reply",
+			reaction:  "rocket",
+			timestamp: "2.0",
+			wantCalls: []string{"/conversations.history", "/conversations.replies", "/users.info", "/chat.getPermalink", "/chat.postMessage", "text:deploy web"},
+		},
+		{
+			name:      "message not found",
+			reaction:  "rocket",
+			timestamp: "9.0",
+			wantCalls: []string{"/conversations.history", "/conversations.replies"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ev := &slack.ReactionAddedEvent{User: "U1", Reaction: tt.reaction}
+			ev.Item.Type = reactionItemMessage
+			ev.Item.Channel = "C1"
+			ev.Item.Timestamp = tt.timestamp
+			if err := s.handleReaction(ev); (err != nil) != tt.wantErr {
+				t.Fatalf("SlackBot.handleReaction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			got := []string{}
+			for len(calls) > 0 {
+				got = append(got, <-calls)
+			}
+			if !reflect.DeepEqual(got, tt.wantCalls) {
+				t.Errorf("SlackBot.handleReaction() called %v, want %v", got, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/nlopes/slack"
+	"github.com/pkg/errors"
+)
+
+const (
+	responseTypeInChannel = "in_channel"
+	responseTypeEphemeral = "ephemeral"
+)
+
+// Replier posts the bot message, where to post depends on the trigger.
+type Replier interface {
+	Reply(text string, params slack.PostMessageParameters) error
+}
+
+// postedMessage is where the message is posted, to update it later.
+type postedMessage struct {
+	Channel   string
+	Timestamp string
+}
+
+// messageTracker is a Replier which knows its posted messages.
+// responseURLReplier is not, the message by response_url has no timestamp.
+type messageTracker interface {
+	Posted() []postedMessage
+}
+
+// channelReplier posts to the channel by API, in the thread if ThreadTS is set.
+type channelReplier struct {
+	API      *slack.Client
+	Channel  string
+	ThreadTS string
+	posted   []postedMessage
+}
+
+func (r *channelReplier) Reply(text string, params slack.PostMessageParameters) error {
+	if r.ThreadTS != "" {
+		params.ThreadTimestamp = r.ThreadTS
+	}
+	channel, timestamp, err := r.API.PostMessage(r.Channel, text, params)
+	if err != nil {
+		return errors.Wrap(err, "post message failed")
+	}
+	r.posted = append(r.posted, postedMessage{Channel: channel, Timestamp: timestamp})
+	return nil
+}
+
+func (r *channelReplier) Posted() []postedMessage {
+	return r.posted
+}
+
+// responseURLReplier posts to response_url of slash command.
+type responseURLReplier struct {
+	URL          string
+	ResponseType string
+}
+
+type responseURLMessage struct {
+	ResponseType string             `json:"response_type"`
+	Text         string             `json:"text"`
+	Attachments  []slack.Attachment `json:"attachments,omitempty"`
+}
+
+func (r *responseURLReplier) Reply(text string, params slack.PostMessageParameters) error {
+	body, err := json.Marshal(&responseURLMessage{
+		ResponseType: r.ResponseType,
+		Text:         text,
+		Attachments:  params.Attachments,
+	})
+	if err != nil {
+		return errors.Wrap(err, "JSON marshal failed")
+	}
+
+	resp, err := http.Post(r.URL, "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrap(err, "post response_url failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("post response_url failed status: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// multiReplier posts to all repliers, e.g. all channels of scheduled config.
+type multiReplier []Replier
+
+func (r multiReplier) Reply(text string, params slack.PostMessageParameters) error {
+	for _, replier := range r {
+		if err := replier.Reply(text, params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r multiReplier) Posted() []postedMessage {
+	posted := []postedMessage{}
+	for _, replier := range r {
+		if tracker, ok := replier.(messageTracker); ok {
+			posted = append(posted, tracker.Posted()...)
+		}
+	}
+	return posted
+}
+
+// channelsReplier posts to all of the channels.
+func (s *SlackBot) channelsReplier(channels []string) Replier {
+	replier := multiReplier{}
+	for _, ch := range channels {
+		replier = append(replier, &channelReplier{API: s.API, Channel: ch})
+	}
+	return replier
+}
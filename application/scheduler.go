@@ -0,0 +1,148 @@
+package application
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juntaki/firestarter/domain"
+	"go.uber.org/zap"
+)
+
+const (
+	schedulerInterval = time.Second
+	schedulerUser     = "scheduler"
+)
+
+// Scheduler runs the configs which have schedule, the result is posted to the channels.
+type Scheduler struct {
+	Bot     *SlackBot
+	Log     *zap.SugaredLogger
+	running map[string]bool // config ID, to skip overlapping runs
+	mutex   *sync.Mutex
+
+	configs  []*domain.Config // scheduled, cached until the revision changes
+	revision uint64
+}
+
+func NewScheduler(Bot *SlackBot, Log *zap.SugaredLogger) *Scheduler {
+	return &Scheduler{
+		Bot:     Bot,
+		Log:     Log,
+		running: make(map[string]bool),
+		mutex:   &sync.Mutex{},
+	}
+}
+
+// Run starts the configs on schedule until the process exits.
+// Runs scheduled while the process is down are not executed nor recorded, it starts from now.
+func (s *Scheduler) Run() error {
+	ticker := time.NewTicker(schedulerInterval)
+	defer ticker.Stop()
+
+	last := time.Now()
+	for now := range ticker.C {
+		s.tick(last, now)
+		last = now
+	}
+	return nil
+}
+
+// tick starts the configs scheduled in (from, to].
+func (s *Scheduler) tick(from, to time.Time) {
+	configs, err := s.scheduledConfigs()
+	if err != nil {
+		s.Log.Errorw("Get config map failed", zap.Error(err))
+		return
+	}
+
+	for _, c := range configs {
+		if s.schedule(c, from, to) {
+			go s.run(c)
+		}
+	}
+}
+
+// scheduledConfigs returns the configs which have schedule, reloaded only if the configs are modified.
+func (s *Scheduler) scheduledConfigs() ([]*domain.Config, error) {
+	// Get the revision first, modified while loading is reloaded on the next tick.
+	revision := s.Bot.ConfigRepository.Revision()
+	if s.configs != nil && revision == s.revision {
+		return s.configs, nil
+	}
+
+	configs, err := s.Bot.ConfigRepository.GetConfigList()
+	if err != nil {
+		return nil, err
+	}
+	s.configs = []*domain.Config{}
+	for _, c := range configs {
+		if c.Schedule != nil {
+			s.configs = append(s.configs, c)
+		}
+	}
+	s.revision = revision
+	return s.configs, nil
+}
+
+// schedule returns true if the config should start now, missed or overlapping runs are recorded.
+func (s *Scheduler) schedule(c *domain.Config, from, to time.Time) bool {
+	if c.Schedule == nil {
+		return false
+	}
+
+	runs := 0
+	for t := c.Schedule.Next(from); !t.After(to); t = c.Schedule.Next(t) {
+		runs++
+	}
+	if runs == 0 {
+		return false
+	}
+	if runs > 1 {
+		s.skip(c, fmt.Sprintf("Missed %d scheduled runs", runs-1))
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.running[c.CallbackID] {
+		s.skip(c, "Skipped, previous run is still running")
+		return false
+	}
+	s.running[c.CallbackID] = true
+	return true
+}
+
+// skip records the run which is not executed.
+func (s *Scheduler) skip(c *domain.Config, reason string) {
+	s.Log.Warnw(reason, zap.String("id", c.CallbackID), zap.String("schedule", c.ScheduleString))
+	execution := domain.NewExecution(c, &domain.SessionValue{}, schedulerUser, strings.Join(c.Channels, ","))
+	execution.Error = reason
+	if err := s.Bot.ExecutionRepository.AddExecution(execution); err != nil {
+		s.Log.Errorw("Record execution failed", zap.Error(err), zap.String("id", c.CallbackID))
+	}
+}
+
+func (s *Scheduler) run(c *domain.Config) {
+	defer func() {
+		s.mutex.Lock()
+		delete(s.running, c.CallbackID)
+		s.mutex.Unlock()
+	}()
+	s.Log.Infow("Schedule Match", zap.String("id", c.CallbackID), zap.String("schedule", c.ScheduleString))
+
+	// Create Session for scheduled request, nothing is matched.
+	msgContext := domain.MessageContext{UserName: schedulerUser, Time: time.Now()}
+	sess, err := s.Bot.Session.Create([]string{}, msgContext)
+	if err != nil {
+		s.Log.Errorw("Create session failed", zap.Error(err))
+		return
+	}
+	s.Log.Infow("Create Session", zap.String("SessionID", sess.ID))
+
+	replier := s.Bot.channelsReplier(c.Channels)
+	err = s.Bot.startRequest(c, sess, replier, strings.Join(c.Channels, ","), schedulerUser)
+	if err != nil {
+		s.Log.Errorw("Scheduled request failed", zap.Error(err), zap.String("id", c.CallbackID))
+	}
+}
@@ -0,0 +1,126 @@
+package application
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juntaki/firestarter/domain"
+	"go.uber.org/zap"
+)
+
+func TestScheduler_schedule(t *testing.T) {
+	config := &domain.Config{
+		CallbackID:     "purge",
+		Channels:       []string{"general"},
+		ScheduleString: "0 3 * * *",
+	}
+	config.Hydrate()
+	base := time.Date(2018, 5, 1, 3, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name        string
+		from        time.Time
+		to          time.Time
+		running     bool
+		want        bool
+		wantSkipped string
+	}{
+		{
+			name: "not yet",
+			from: base.Add(-2 * time.Second),
+			to:   base.Add(-time.Second),
+			want: false,
+		},
+		{
+			name: "due",
+			from: base.Add(-time.Second),
+			to:   base,
+			want: true,
+		},
+		{
+			name:        "missed",
+			from:        base.Add(-48*time.Hour - time.Second),
+			to:          base,
+			want:        true,
+			wantSkipped: "Missed 2 scheduled runs",
+		},
+		{
+			name:        "overlapping",
+			from:        base.Add(-time.Second),
+			to:          base,
+			running:     true,
+			want:        false,
+			wantSkipped: "Skipped, previous run is still running",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executions := &DummyExecutionRepository{}
+			s := NewScheduler(&SlackBot{ExecutionRepository: executions}, zap.NewNop().Sugar())
+			s.running[config.CallbackID] = tt.running
+
+			if got := s.schedule(config, tt.from, tt.to); got != tt.want {
+				t.Errorf("Scheduler.schedule() = %v, want %v", got, tt.want)
+			}
+			skipped := ""
+			if len(executions.executions) > 0 {
+				skipped = executions.executions[0].Error
+			}
+			if skipped != tt.wantSkipped {
+				t.Errorf("Scheduler.schedule() skipped = %q, want %q", skipped, tt.wantSkipped)
+			}
+			if s.running[config.CallbackID] != (tt.want || tt.running) {
+				t.Errorf("Scheduler.schedule() running = %v", s.running[config.CallbackID])
+			}
+		})
+	}
+}
+
+func TestScheduler_scheduledConfigs(t *testing.T) {
+	schedule := func(ID, spec string) *domain.Config {
+		c := &domain.Config{CallbackID: ID, ScheduleString: spec}
+		c.Hydrate()
+		return c
+	}
+	loads := 0
+	configMap := domain.ConfigMap{"purge": schedule("purge", "0 3 * * *"), "deploy": schedule("deploy", "")}
+	repo := &DummyConfigRepository{
+		dummyGetConfigList: func() (domain.ConfigMap, error) {
+			loads++
+			return configMap, nil
+		},
+	}
+	s := NewScheduler(&SlackBot{ConfigRepository: repo}, zap.NewNop().Sugar())
+
+	check := func(wantLoads int, wantIDs ...string) {
+		t.Helper()
+		configs, err := s.scheduledConfigs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		IDs := []string{}
+		for _, c := range configs {
+			IDs = append(IDs, c.CallbackID)
+		}
+		sort.Strings(IDs)
+		if loads != wantLoads || strings.Join(IDs, ",") != strings.Join(wantIDs, ",") {
+			t.Errorf("Scheduler.scheduledConfigs() = %v, loaded %d times, want %v, %d times", IDs, loads, wantIDs, wantLoads)
+		}
+	}
+	check(1, "purge")
+	check(1, "purge")
+
+	configMap["backup"] = schedule("backup", "0 4 * * *")
+	check(1, "purge")
+	repo.revision++
+	check(2, "backup", "purge")
+	check(2, "backup", "purge")
+
+	// Not loaded again if no config has schedule.
+	configMap = domain.ConfigMap{}
+	repo.revision++
+	check(3)
+	check(3)
+}
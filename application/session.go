@@ -4,61 +4,66 @@ import (
 	"strings"
 	"time"
 
-	"github.com/juntaki/expiresync"
+	"github.com/juntaki/firestarter/domain"
+	"github.com/pkg/errors"
 	"github.com/rs/xid"
 )
 
 type Session struct {
-	store  *expiresync.Map
+	store  domain.SessionStore
 	expire time.Duration
 	size   int
 }
 
 const expire = 1 * time.Hour
 
-func NewSession() *Session {
+func NewSession(store domain.SessionStore) *Session {
 	return &Session{
-		store:  expiresync.NewMap(),
+		store:  store,
 		expire: expire,
 		size:   0,
 	}
 }
 
-type SessionValue struct {
-	matched []string
-	value   string
-	id      string
-}
-
-func (s *Session) Get(callbackID string) (*SessionValue, bool) {
+func (s *Session) Get(callbackID string) (*domain.SessionValue, bool) {
 	sessionID := s.getSessionID(callbackID)
-	sess, ok := s.store.Get(sessionID)
-	if !ok {
-		return nil, false
-	}
-	return sess.(*SessionValue), true
+	return s.store.Get(sessionID)
 }
 
-func (s *Session) Set(callbackID string, sess *SessionValue) {
+func (s *Session) Set(callbackID string, sess *domain.SessionValue) error {
 	sessionID := s.getSessionID(callbackID)
-	s.store.Set(sessionID, sess, s.expire)
+	if err := s.store.Set(sessionID, sess, s.expire); err != nil {
+		return errors.Wrap(err, "Set session failed")
+	}
 	s.size++
 
 	if s.size > 100 {
-		s.store.DeleteExpired()
 		s.size = 0
+		if err := s.store.DeleteExpired(); err != nil {
+			return errors.Wrap(err, "Delete expired session failed")
+		}
 	}
+	return nil
 }
 
-func (s *Session) Create(matched []string) *SessionValue {
+func (s *Session) Create(matched []string, context domain.MessageContext) (*domain.SessionValue, error) {
+	return s.CreateWithPayload(matched, context, nil)
+}
+
+// CreateWithPayload creates the session with webhook payload.
+func (s *Session) CreateWithPayload(matched []string, context domain.MessageContext, payload interface{}) (*domain.SessionValue, error) {
 	sessionID := xid.New().String()
-	sess := &SessionValue{
-		matched: matched,
-		value:   "",
-		id:      sessionID,
+	sess := &domain.SessionValue{
+		Matched: matched,
+		Value:   "",
+		ID:      sessionID,
+		Payload: payload,
+		Context: context,
+	}
+	if err := s.store.Set(sessionID, sess, s.expire); err != nil {
+		return nil, errors.Wrap(err, "Create session failed")
 	}
-	s.store.Set(sessionID, sess, s.expire)
-	return sess
+	return sess, nil
 }
 
 func (s *Session) getSessionID(callbackID string) string {
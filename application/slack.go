@@ -1,13 +1,14 @@
 package application
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/juntaki/firestarter/domain"
 	"github.com/nlopes/slack"
@@ -27,30 +28,49 @@ const (
 )
 
 type SlackBot struct {
-	VerificationToken string
-	API               *slack.Client
-	ConfigRepository  domain.ConfigRepository
-	Log               *zap.SugaredLogger
-	Session           *Session
-	channelCache      map[string]string
-	sqsMode           bool
+	VerificationToken   string
+	SigningSecret       string // verify X-Slack-Signature instead of the token, if set
+	API                 *slack.Client
+	ConfigRepository    domain.ConfigRepository
+	AuditRepository     domain.AuditRepository
+	ExecutionRepository domain.ExecutionRepository
+	Log                 *zap.SugaredLogger
+	Session             *Session
+	channelCache        map[string]string
+	eventDeduper        *eventDeduper
+	approvalMutex       *sync.Mutex // serialize approvals of the session
+	optionsCache        *optionsCache
+	botID               string
+	botIDMutex          *sync.Mutex
+	updateByAPI         bool // HTTP response doesn't reach Slack, e.g. SQS proxy or Socket Mode.
 }
 
 func NewSlackBot(
 	VerificationToken string,
+	SigningSecret string,
 	API *slack.Client,
 	ConfigRepository domain.ConfigRepository,
+	AuditRepository domain.AuditRepository,
+	ExecutionRepository domain.ExecutionRepository,
 	Log *zap.SugaredLogger,
-	sqsMode bool,
+	SessionStore domain.SessionStore,
+	updateByAPI bool,
 ) *SlackBot {
 	return &SlackBot{
-		VerificationToken: VerificationToken,
-		API:               API,
-		ConfigRepository:  ConfigRepository,
-		Log:               Log,
-		Session:           NewSession(),
-		channelCache:      make(map[string]string),
-		sqsMode:           sqsMode,
+		VerificationToken:   VerificationToken,
+		SigningSecret:       SigningSecret,
+		API:                 API,
+		ConfigRepository:    ConfigRepository,
+		AuditRepository:     AuditRepository,
+		ExecutionRepository: ExecutionRepository,
+		Log:                 Log,
+		Session:             NewSession(SessionStore),
+		channelCache:        make(map[string]string),
+		eventDeduper:        newEventDeduper(),
+		approvalMutex:       &sync.Mutex{},
+		optionsCache:        newOptionsCache(),
+		botIDMutex:          &sync.Mutex{},
+		updateByAPI:         updateByAPI,
 	}
 }
 
@@ -77,12 +97,35 @@ func (s *SlackBot) InteractiveMessageHandler(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	if message.Token != s.VerificationToken {
-		s.Log.Errorf("Invalid token: %s", message.Token)
+	if !s.verifyRequest(r.Header, buf, message.Token) {
+		s.Log.Errorf("Invalid signature or token: %s", message.Token)
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
 
+	if !isAttachmentAction(&message) {
+		s.Log.Errorw("Invalid interactive message", zap.String("callbackID", message.CallbackID))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.handleInteractive(w, &message)
+}
+
+// isAttachmentAction returns true if the message has what handleInteractive reads,
+// other interactions like shortcuts and block actions are not handled.
+func isAttachmentAction(message *slack.AttachmentActionCallback) bool {
+	if len(message.Actions) == 0 || len(message.OriginalMessage.Attachments) == 0 {
+		return false
+	}
+	if !strings.Contains(message.CallbackID, "@") {
+		return false
+	}
+	return message.Actions[0].Name != actionSelect || len(message.Actions[0].SelectedOptions) > 0
+}
+
+// handleInteractive processes the verified interactive message, and responds the updated message.
+func (s *SlackBot) handleInteractive(w http.ResponseWriter, message *slack.AttachmentActionCallback) {
 	// Load config
 	config, err := s.ConfigRepository.GetConfigList()
 	if err != nil {
@@ -107,18 +150,58 @@ func (s *SlackBot) InteractiveMessageHandler(w http.ResponseWriter, r *http.Requ
 	action := message.Actions[0]
 	s.Log.Infow("Request verified", zap.String("action", action.Name))
 
+	// Approvers decide who can start if set, otherwise the users who can trigger.
+	restricted := action.Name == actionSelect || (action.Name == actionStart && len(q.Approvers) == 0)
+	if restricted && !s.isAllowed(q, message.User.ID) {
+		s.replyEphemeral(message.Channel.ID, message.User.ID, notAllowedText(q))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if action.Name == actionCancel && !s.canCancel(q, sess, message.User.ID) {
+		s.replyEphemeral(message.Channel.ID, message.User.ID, fmt.Sprintf(":no_entry: You are not allowed to cancel %s", q.Title))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
 	switch action.Name {
 	case actionSelect:
 		value := action.SelectedOptions[0].Value
+		steps := q.InteractiveSteps()
+		if sess.Step >= len(steps) || !sess.IsOffered(steps[sess.Step], value) {
+			s.Log.Errorw("Invalid selection", zap.String("callbackID", message.CallbackID), zap.String("value", value))
+			s.responseMessage(w, message.OriginalMessage, ":x: Invalid selection", "", message.Channel)
+			return
+		}
 		s.Log.Infow("Update Session", zap.String("callbackID", message.CallbackID), zap.String("value", value))
-		sess.value = value
-		s.Session.Set(message.CallbackID, sess)
+		sess.Select(steps[sess.Step], value)
+		sess.SelectedBy(message.User.ID)
+		var next slack.Attachment
+		if sess.Step < len(steps) {
+			next, err = s.stepAttachment(q, sess)
+			if err != nil {
+				s.Log.Errorw("Next step failed", zap.Error(err))
+				s.responseMessage(w, message.OriginalMessage, ":x: "+q.ExecSecretValueMask(err.Error()), "", message.Channel)
+				return
+			}
+		}
+		if err := s.Session.Set(message.CallbackID, sess); err != nil {
+			s.Log.Errorw("Update session failed", zap.Error(err))
+			s.responseMessage(w, message.OriginalMessage, ":x: "+err.Error(), "", message.Channel)
+			return
+		}
+
+		if sess.Step < len(steps) {
+			// Overwrite original drop down message by the next step.
+			originalMessage := message.OriginalMessage
+			originalMessage.Attachments[0] = next
+			s.updateMessage(w, originalMessage, message.Channel)
+			return
+		}
 
-		if q.Confirm {
+		if q.IsConfirmRequired() {
 			// Overwrite original drop down message.
 			originalMessage := message.OriginalMessage
-			originalMessage.Attachments[0].Text =
-				fmt.Sprintf("OK to select %s ?", strings.Title(sess.value))
+			originalMessage.Attachments[0].Text = confirmText(q, sess)
 			originalMessage.Attachments[0].Actions = []slack.AttachmentAction{
 				{
 					Name:  actionStart,
@@ -135,45 +218,33 @@ func (s *SlackBot) InteractiveMessageHandler(w http.ResponseWriter, r *http.Requ
 				},
 			}
 
-			w.Header().Add("Content-type", "application/json")
-			w.WriteHeader(http.StatusOK)
-			json.NewEncoder(w).Encode(&originalMessage)
-
-			if s.sqsMode {
-				_, _, _, err := s.API.SendMessage(
-					message.Channel.ID,
-					slack.MsgOptionUpdate(originalMessage.Timestamp),
-					slack.MsgOptionAttachments(originalMessage.Attachments[0]),
-					slack.MsgOptionText(originalMessage.Text, false),
-				)
-				if err != nil {
-					s.Log.Error(err)
-				}
-			}
+			s.updateMessage(w, originalMessage, message.Channel)
 			return
 		} else {
-			err := s.SendRequest(q, sess)
-			if err != nil {
-				s.Log.Errorw("Send request failed", zap.Error(err))
-				s.responseMessage(w, message.OriginalMessage, ":x: "+err.Error(), "", message.Channel)
-			} else {
-				title := fmt.Sprintf(":ok: @%s start this, %s", message.User.Name, sess.value)
-				s.responseMessage(w, message.OriginalMessage, title, "", message.Channel)
-			}
+			title := fmt.Sprintf(":ok: @%s start this, %s", message.User.Name, selectedText(q, sess))
+			s.executeRequest(w, message, q, sess, title)
 			return
 		}
 	case actionStart: // 3. OK button
-		err = s.SendRequest(q, sess)
+		sess, err = s.approve(q, sess, message.CallbackID, message.User.ID)
 		if err != nil {
-			s.Log.Errorw("Send request failed", zap.Error(err))
-			s.responseMessage(w, message.OriginalMessage, ":x: "+err.Error(), "", message.Channel)
-		} else {
-			title := fmt.Sprintf(":ok: @%s confirmed, %s", message.User.Name, (sess).value)
-			s.responseMessage(w, message.OriginalMessage, title, "", message.Channel)
+			s.Log.Infow("Approval rejected", zap.Error(err), zap.String("user", message.User.Name))
+			s.approvalMessage(w, message.OriginalMessage, q, sess, fmt.Sprintf(":x: @%s: %s", message.User.Name, err.Error()), message.Channel)
+			return
 		}
+		if !q.IsApproved(sess) {
+			s.approvalMessage(w, message.OriginalMessage, q, sess, "", message.Channel)
+			return
+		}
+
+		title := fmt.Sprintf(":ok: @%s confirmed, %s", message.User.Name, selectedText(q, sess))
+		if q.ApprovalQuorum() > 1 {
+			title += ", approved by " + mentions(sess.Approvals)
+		}
+		s.executeRequest(w, message, q, sess, title)
 		return
 	case actionCancel: // 3. Cancel button
-		s.Log.Infow("Request canceled", zap.String("Session ID", sess.id))
+		s.Log.Infow("Request canceled", zap.String("Session ID", sess.ID))
 		title := fmt.Sprintf(":x: @%s canceled the request", message.User.Name)
 		s.responseMessage(w, message.OriginalMessage, title, "", message.Channel)
 		return
@@ -185,6 +256,11 @@ func (s *SlackBot) InteractiveMessageHandler(w http.ResponseWriter, r *http.Requ
 }
 
 func (s *SlackBot) responseMessage(w http.ResponseWriter, original slack.Message, title, value string, channel slack.Channel) {
+	s.updateMessage(w, resultMessage(original, title, value), channel)
+}
+
+// resultMessage replaces the buttons of the original message by the result.
+func resultMessage(original slack.Message, title, value string) slack.Message {
 	original.Attachments[0].Actions = []slack.AttachmentAction{} // empty buttons
 	original.Attachments[0].Fields = []slack.AttachmentField{
 		{
@@ -193,142 +269,366 @@ func (s *SlackBot) responseMessage(w http.ResponseWriter, original slack.Message
 			Short: false,
 		},
 	}
+	return original
+}
+
+// executeRequest sends the request and responds the result.
+// Retries may take longer than Slack waits for the response,
+// then it responds first, and updates the message by API after the request.
+func (s *SlackBot) executeRequest(w http.ResponseWriter, message *slack.AttachmentActionCallback, c *domain.Config, sess *domain.SessionValue, title string) {
+	if c.RetryMaxAttempts <= 1 {
+		s.updateMessage(w, s.requestResult(message, c, sess, title), message.Channel)
+		return
+	}
 
+	s.updateMessage(w, resultMessage(message.OriginalMessage, title, ":hourglass_flowing_sand: Sending..."), message.Channel)
+	go func() {
+		result := s.requestResult(message, c, sess, title)
+		if err := s.chatUpdate(message.Channel.ID, message.MessageTs, result); err != nil {
+			s.Log.Errorw("Update result failed", zap.Error(err), zap.String("id", c.CallbackID))
+		}
+	}()
+}
+
+// requestResult sends the request, and returns the message with the result.
+// The job is tracked if the config polls the status.
+func (s *SlackBot) requestResult(message *slack.AttachmentActionCallback, c *domain.Config, sess *domain.SessionValue, title string) slack.Message {
+	resp, err := s.executor().execute(c, sess, message.User.Name, message.Channel.ID)
+	if err != nil {
+		s.Log.Errorw("Send request failed", zap.Error(err))
+		return resultMessage(message.OriginalMessage, ":x: "+err.Error(), "")
+	}
+
+	result := resultMessage(message.OriginalMessage, title, s.compileResponse(c, sess, resp))
+	if c.IsStatusPolling() {
+		j, attachments := s.startJob(c, sess, resp, result.Text, result.Attachments)
+		result.Attachments = attachments
+		if j != nil {
+			j.messages = []postedMessage{{Channel: message.Channel.ID, Timestamp: message.MessageTs}}
+			go s.trackJob(j)
+		}
+	}
+	return result
+}
+
+// updateMessage responds the message to replace the original,
+// and updates it by API too if the response doesn't reach Slack.
+func (s *SlackBot) updateMessage(w http.ResponseWriter, message slack.Message, channel slack.Channel) {
 	w.Header().Add("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(&original)
-
-	if s.sqsMode {
-		_, _, _, err := s.API.SendMessage(
-			channel.ID,
-			slack.MsgOptionUpdate(original.Timestamp),
-			slack.MsgOptionAttachments(original.Attachments[0]),
-			slack.MsgOptionText(original.Text, false),
-		)
-		if err != nil {
+	json.NewEncoder(w).Encode(&message)
+
+	if s.updateByAPI {
+		if err := s.chatUpdate(channel.ID, message.Timestamp, message); err != nil {
 			s.Log.Error(err)
 		}
 	}
 }
 
-func (s *SlackBot) ProcessNonInteractiveRequest(c *domain.Config, sess *SessionValue, channel string) error {
-	err := s.SendRequest(c, sess)
+// chatUpdate replaces the message at the timestamp by API.
+func (s *SlackBot) chatUpdate(channelID, timestamp string, message slack.Message) error {
+	_, _, _, err := s.API.SendMessage(
+		channelID,
+		slack.MsgOptionUpdate(timestamp),
+		slack.MsgOptionAttachments(message.Attachments...),
+		slack.MsgOptionText(message.Text, false),
+	)
+	return err
+}
+
+func (s *SlackBot) ProcessNonInteractiveRequest(c *domain.Config, sess *domain.SessionValue, replier Replier, channel, user string) error {
+	resp, err := s.executor().execute(c, sess, user, channel)
 	if err != nil {
-		_, _, cause := s.API.PostMessage(channel,
-			":x: "+err.Error(),
-			slack.PostMessageParameters{})
+		cause := replier.Reply(":x: "+err.Error(), slack.PostMessageParameters{})
 		if cause != nil {
-			return errors.Wrap(cause, "post message failed")
+			return cause
 		}
 	} else {
-		text, err := c.TextCompile(sess.matched)
+		text, err := c.TextCompile(sess)
 		if err != nil {
 			return err
 		}
 
-		_, _, cause := s.API.PostMessage(channel, text, slack.PostMessageParameters{})
+		params := slack.PostMessageParameters{}
+		if response := s.compileResponse(c, sess, resp); response != "" {
+			params.Attachments = []slack.Attachment{
+				{
+					Text: response,
+				},
+			}
+		}
+
+		// The message by response_url can't be updated, the status is not tracked.
+		tracker, ok := replier.(messageTracker)
+		var j *job
+		if ok && c.IsStatusPolling() {
+			j, params.Attachments = s.startJob(c, sess, resp, text, params.Attachments)
+		}
+
+		cause := replier.Reply(text, params)
 		if cause != nil {
-			return errors.Wrap(cause, "post message failed")
+			return cause
+		}
+		if j != nil {
+			j.messages = tracker.Posted()
+			go s.trackJob(j)
 		}
 	}
 	return nil
 }
 
-func (s *SlackBot) ProcessInteractiveRequest(c *domain.Config, sess *SessionValue, channel string) error {
+func (s *SlackBot) ProcessInteractiveRequest(c *domain.Config, sess *domain.SessionValue, replier Replier) error {
+	text, err := c.TextCompile(sess)
+	if err != nil {
+		return err
+	}
+
+	attachment, err := s.stepAttachment(c, sess)
+	if err != nil {
+		s.Log.Errorw("Load options failed", zap.Error(err), zap.String("id", c.CallbackID))
+		return replier.Reply(":x: "+c.ExecSecretValueMask(err.Error()), slack.PostMessageParameters{})
+	}
+	// Remember the offered options to validate the selection.
+	if err := s.Session.Set(c.CallbackID+"@"+sess.ID, sess); err != nil {
+		return err
+	}
+
+	params := slack.PostMessageParameters{
+		Attachments: []slack.Attachment{attachment},
+	}
+
+	if err := replier.Reply(text, params); err != nil {
+		return err
+	}
+	s.Log.Info("Response posted")
+	return nil
+}
+
+// stepAttachment returns the drop down of the current step, and sets the offered options to the session.
+func (s *SlackBot) stepAttachment(c *domain.Config, sess *domain.SessionValue) (slack.Attachment, error) {
+	step := c.InteractiveSteps()[sess.Step]
+	options, err := s.stepOptions(c, sess)
+	if err != nil {
+		return slack.Attachment{}, err
+	}
 	opt := make([]slack.AttachmentActionOption, 0)
-	for _, a := range c.Actions {
+	sess.Options = nil
+	for _, o := range options {
 		opt = append(opt, slack.AttachmentActionOption{
-			Text:  a,
-			Value: a,
+			Text:  o.Label,
+			Value: o.Value,
 		})
+		sess.Options = append(sess.Options, o.Value)
 	}
-	params := slack.PostMessageParameters{
-		Attachments: []slack.Attachment{
-			slack.Attachment{
-				Text:       "Select your choice",
-				Color:      "#f9a41b",
-				CallbackID: c.CallbackID + "@" + sess.id,
-				Actions: []slack.AttachmentAction{
-					{
-						Name:    actionSelect,
-						Type:    seletType,
-						Options: opt,
-					},
-					{
-						Name:  actionCancel,
-						Text:  "Cancel",
-						Type:  "button",
-						Style: "danger",
-					},
-				},
+	text := step.Text
+	if text == "" {
+		text = domain.DefaultStepText
+	}
+	return slack.Attachment{
+		Text:       text,
+		Color:      "#f9a41b",
+		CallbackID: c.CallbackID + "@" + sess.ID,
+		Actions: []slack.AttachmentAction{
+			{
+				Name:    actionSelect,
+				Type:    seletType,
+				Options: opt,
+			},
+			{
+				Name:  actionCancel,
+				Text:  "Cancel",
+				Type:  "button",
+				Style: "danger",
 			},
 		},
+	}, nil
+}
+
+// selectedText returns the chosen values to show, in order of steps.
+func selectedText(c *domain.Config, sess *domain.SessionValue) string {
+	if len(c.Steps) == 0 {
+		return sess.Value
+	}
+	selected := []string{}
+	for _, step := range c.Steps {
+		selected = append(selected, step.Name+": "+sess.Values[step.Name])
 	}
+	return strings.Join(selected, ", ")
+}
 
-	text, err := c.TextCompile(sess.matched)
+func (s *SlackBot) executor() *executor {
+	return &executor{
+		AuditRepository:     s.AuditRepository,
+		ExecutionRepository: s.ExecutionRepository,
+		Log:                 s.Log,
+	}
+}
+
+// compileResponse renders ResponseTemplate, the error is shown as reply instead.
+func (s *SlackBot) compileResponse(c *domain.Config, sess *domain.SessionValue, resp *domain.Response) string {
+	response, err := c.ResponseCompile(sess, resp)
 	if err != nil {
-		return err
+		s.Log.Errorw("Compile response failed", zap.Error(err))
+		return ":warning: " + err.Error()
+	}
+	return response
+}
+
+func (s *SlackBot) getChannelName(channelID string) (string, error) {
+	if id, ok := s.channelCache[channelID]; ok {
+		return id, nil
+	}
+
+	ch, err := s.API.GetConversationInfo(channelID, false)
+	if err != nil {
+		return "", err
 	}
+	return ch.Name, nil
+}
 
-	_, _, err = s.API.PostMessage(channel, text, params)
+func (s *SlackBot) getUserName(userID string) string {
+	user, err := s.API.GetUserInfo(userID)
 	if err != nil {
-		return errors.Wrap(err, "post message failed")
+		s.Log.Infow("Get user info failed", zap.String("user", userID), zap.Error(err))
+		return userID
 	}
-	s.Log.Info("Response posted")
-	return nil
+	return user.Name
 }
 
-func (s *SlackBot) SendRequest(c *domain.Config, sess *SessionValue) error {
-	url, err := c.URLCompile(sess.value, sess.matched, c.Secrets)
+// messageContext returns the context of the message in the channel, passed to templates.
+func (s *SlackBot) messageContext(channelID, channelName, userID string, msg *slack.Msg) domain.MessageContext {
+	msgContext := domain.MessageContext{
+		UserID:      userID,
+		ChannelID:   channelID,
+		ChannelName: channelName,
+		Timestamp:   msg.Timestamp,
+		ThreadTS:    msg.ThreadTimestamp,
+		Time:        time.Now(),
+	}
+	if userID != "" {
+		msgContext.UserName = s.getUserName(userID)
+	}
+	if msg.Timestamp != "" {
+		permalink, err := s.API.GetPermalink(&slack.PermalinkParameters{Channel: channelID, Ts: msg.Timestamp})
+		if err != nil {
+			s.Log.Infow("Get permalink failed", zap.String("channel", channelID), zap.Error(err))
+		} else {
+			msgContext.Permalink = permalink
+		}
+	}
+	return msgContext
+}
+
+// handleMessage finds the config matched to the message, and starts the request.
+func (s *SlackBot) handleMessage(msg *slack.Msg, botID string) error {
+	s.Log.Debugw("Message bot ID", zap.String("ID", msg.BotID))
+	if msg.BotID == botID {
+		return nil
+	}
+
+	// Get config on each event, it may be updated.
+	config, err := s.ConfigRepository.GetConfigList()
 	if err != nil {
 		return err
 	}
 
-	body, err := c.BodyCompile(sess.value, sess.matched, c.Secrets)
+	name, err := s.getChannelName(msg.Channel)
 	if err != nil {
 		return err
 	}
 
-	s.Log.Infow("Send Request",
-		zap.String("url", url),
-		zap.String("body", body),
-	)
+	message := messageText(msg)
+	s.Log.Debugw("Message to be parsed", zap.String("message", message))
+
+	// Fire all matched configs, the first error is returned after all.
+	var result error
+	inThread := msg.ThreadTimestamp != "" && msg.ThreadTimestamp != msg.Timestamp
+	for _, c := range config.FindAllMatched(name, message, inThread) {
+		if err := s.handleMatched(c, msg, name, message); err != nil {
+			s.Log.Errorw("Handle matched config failed", zap.Error(err), zap.String("id", c.CallbackID))
+			if result == nil {
+				result = err
+			}
+		}
+	}
+	return result
+}
 
-	req, err := http.NewRequest(
-		"POST",
-		url,
-		bytes.NewBuffer([]byte(body)),
+// handleMatched starts the request of the config matched to the message.
+func (s *SlackBot) handleMatched(c *domain.Config, msg *slack.Msg, channelName, message string) error {
+	s.Log.Infow("Message Match", zap.String("id", c.CallbackID),
+		zap.String("regexp", c.Regexp.String()),
+		zap.String("message", message),
 	)
+	if !s.isAllowed(c, msg.User) {
+		s.replyEphemeral(msg.Channel, msg.User, notAllowedText(c))
+		return nil
+	}
+
+	// Create Session for matched request
+	msgContext := s.messageContext(msg.Channel, channelName, msg.User, msg)
+	sess, err := s.Session.Create(c.Regexp.FindStringSubmatch(message), msgContext)
 	if err != nil {
-		return errors.Wrap(err, "Cannot make request")
+		return errors.Wrap(err, "create session")
 	}
+	s.Log.Infow("Create Session", zap.String("SessionID", sess.ID))
 
-	req.Header.Set("Content-Type", "application/json")
+	replier := &channelReplier{API: s.API, Channel: msg.Channel, ThreadTS: c.ReplyThreadTS(msgContext)}
+	return s.startRequest(c, sess, replier, msg.Channel, msgContext.UserName)
+}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return errors.New("POST request failed")
+// startRequest sends the request or asks the action to the user.
+func (s *SlackBot) startRequest(c *domain.Config, sess *domain.SessionValue, replier Replier, channel, user string) error {
+	if c.IsConfirmRequired() && !c.IsInteractive() {
+		// Saved before it was validated, never send without the approval.
+		s.Log.Errorw("Approval without selection", zap.String("id", c.CallbackID))
+		return replier.Reply(":x: Approval requires Actions, Steps or Options URL", slack.PostMessageParameters{})
 	}
-	defer resp.Body.Close()
-	if !(resp.StatusCode >= 200 && resp.StatusCode <= 299) {
-		s.Log.Infof("Send request failed status: %d", resp.StatusCode)
-		return errors.Errorf("Send request failed status: %d", resp.StatusCode)
+	// No Action nor Step means non interactive request
+	if !c.IsInteractive() {
+		err := s.ProcessNonInteractiveRequest(c, sess, replier, channel, user)
+		if err != nil {
+			return errors.Wrap(err, "process non interactive")
+		}
+	} else {
+		err := s.ProcessInteractiveRequest(c, sess, replier)
+		if err != nil {
+			return errors.Wrap(err, "process interactive")
+		}
 	}
-	s.Log.Info("Send request success")
 	return nil
 }
 
-func (s *SlackBot) getChannelName(channelID string) (string, error) {
-	if id, ok := s.channelCache[channelID]; ok {
-		return id, nil
+// messageText returns the text to be matched, IFTTT message has it in attachment.
+func messageText(msg *slack.Msg) string {
+	message := msg.Text
+	if message == "" && len(msg.Attachments) > 0 { // IFTTT message with title
+		message = msg.Attachments[0].Text
+	}
+	if message == "" && len(msg.Attachments) > 0 { // IFTTT message only
+		message = msg.Attachments[0].Pretext
 	}
+	return message
+}
 
-	ch, err := s.API.GetConversationInfo(channelID, false)
+// getBotID returns own bot ID to ignore own messages, cached after first call.
+func (s *SlackBot) getBotID() (string, error) {
+	s.botIDMutex.Lock()
+	defer s.botIDMutex.Unlock()
+
+	if s.botID != "" {
+		return s.botID, nil
+	}
+	auth, err := s.API.AuthTest()
 	if err != nil {
 		return "", err
 	}
-	return ch.Name, nil
+	bot, err := s.API.GetUserInfo(auth.UserID)
+	if err != nil {
+		return "", err
+	}
+	s.botID = bot.Profile.BotID
+	return s.botID, nil
 }
 
 func (s *SlackBot) Run() {
@@ -346,69 +646,24 @@ func (s *SlackBot) start() error {
 	rtm := s.API.NewRTM()
 	go rtm.ManageConnection()
 
-	auth, err := s.API.AuthTest()
-	if err != nil {
-		return err
-	}
-	bot, err := s.API.GetUserInfo(auth.UserID)
+	botID, err := s.getBotID()
 	if err != nil {
 		return err
 	}
-	s.Log.Debugw("Firestarter bot ID", zap.String("ID", bot.Profile.BotID))
+	s.Log.Debugw("Firestarter bot ID", zap.String("ID", botID))
 
+	// Errors of an event are logged, returning them stops the bot.
 	for msg := range rtm.IncomingEvents {
 		switch ev := msg.Data.(type) {
 		case *slack.HelloEvent:
 			s.Log.Info("Hello Event")
 		case *slack.MessageEvent:
-			s.Log.Debugw("Message bot ID", zap.String("ID", ev.Msg.BotID))
-			if ev.Msg.BotID == bot.Profile.BotID {
-				break
-			}
-			// Get config on each event, it may be updated.
-			config, err := s.ConfigRepository.GetConfigList()
-			if err != nil {
-				return err
-			}
-
-			name, err := s.getChannelName(ev.Msg.Channel)
-			if err != nil {
-				return err
-			}
-
-			message := ev.Msg.Text
-			if message == "" && len(ev.Msg.Attachments) > 0 { // IFTTT message with title
-				message = ev.Msg.Attachments[0].Text
-			}
-			if message == "" && len(ev.Msg.Attachments) > 0 { // IFTTT message only
-				message = ev.Msg.Attachments[0].Pretext
-			}
-
-			s.Log.Debugw("Message to be parsed", zap.String("message", message))
-			c := config.FindMatched(name, message)
-			if c == nil {
-				break
+			if err := s.handleMessage(&ev.Msg, botID); err != nil {
+				s.Log.Errorw("Handle message failed", zap.Error(err))
 			}
-			s.Log.Infow("RTM Match", zap.String("id", c.CallbackID),
-				zap.String("regexp", c.Regexp.String()),
-				zap.String("message", message),
-			)
-
-			// Create Session for matched request
-			sess := s.Session.Create(c.Regexp.FindStringSubmatch(message))
-			s.Log.Infow("Create Session", zap.String("SessionID", sess.id))
-
-			// No Action means non interactive request
-			if len(c.Actions) == 0 {
-				err := s.ProcessNonInteractiveRequest(c, sess, ev.Channel)
-				if err != nil {
-					return errors.Wrap(err, "process non interactive")
-				}
-			} else {
-				err := s.ProcessInteractiveRequest(c, sess, ev.Channel)
-				if err != nil {
-					return errors.Wrap(err, "process interactive")
-				}
+		case *slack.ReactionAddedEvent:
+			if err := s.handleReaction(ev); err != nil {
+				s.Log.Errorw("Handle reaction failed", zap.Error(err))
 			}
 		case *slack.InvalidAuthEvent:
 			return errors.New("Invalid credentials")
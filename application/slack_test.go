@@ -0,0 +1,638 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/juntaki/firestarter/domain"
+	"github.com/nlopes/slack"
+	"go.uber.org/zap"
+)
+
+func TestSlackBot_handleInteractive_steps(t *testing.T) {
+	requests := make(chan string, 1)
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- r.URL.RawQuery
+	}))
+	defer target.Close()
+
+	deploy := &domain.Config{
+		CallbackID:         "deploy",
+		Channels:           []string{"general"},
+		TextTemplateString: "deploy",
+		RegexpString:       "^deploy$",
+		Steps: []domain.Step{
+			{Name: "env", Text: "Select environment", Options: []string{"staging", "production"}},
+			{Name: "version", Text: "Select version", Options: []string{"v1", "v2"}},
+		},
+		URLTemplateString: target.URL + "?env={{.values.env}}&version={{.value}}",
+	}
+	deploy.Hydrate()
+
+	s := &SlackBot{
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				return domain.ConfigMap{"deploy": deploy}, nil
+			},
+		},
+		AuditRepository:     &DummyAuditRepository{},
+		ExecutionRepository: &DummyExecutionRepository{},
+		Log:                 zap.NewNop().Sugar(),
+		Session:             NewSession(&DummySessionStore{sessions: map[string]*domain.SessionValue{}}),
+	}
+	sess, err := s.Session.Create([]string{"deploy"}, domain.MessageContext{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		value     string
+		wantField string
+		wantText  string
+	}{
+		{
+			name:      "invalid option",
+			value:     "v1",
+			wantField: ":x: Invalid selection",
+			wantText:  "Select environment",
+		},
+		{
+			name:     "first step",
+			value:    "staging",
+			wantText: "Select version",
+		},
+		{
+			name:      "last step",
+			value:     "v2",
+			wantField: ":ok: @alice start this, env: staging, version: v2",
+			wantText:  "Select environment",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := &slack.AttachmentActionCallback{
+				CallbackID: "deploy@" + sess.ID,
+				Actions: []slack.AttachmentAction{
+					{Name: actionSelect, SelectedOptions: []slack.AttachmentActionOption{{Value: tt.value}}},
+				},
+				OriginalMessage: slack.Message{
+					Msg: slack.Msg{Attachments: []slack.Attachment{{Text: "Select environment"}}},
+				},
+			}
+			message.User.Name = "alice"
+
+			w := httptest.NewRecorder()
+			s.handleInteractive(w, message)
+
+			got := &slack.Message{}
+			if err := json.NewDecoder(w.Body).Decode(got); err != nil {
+				t.Fatal(err)
+			}
+			attachment := got.Attachments[0]
+			field := ""
+			if len(attachment.Fields) > 0 {
+				field = attachment.Fields[0].Title
+			}
+			if attachment.Text != tt.wantText || field != tt.wantField {
+				t.Errorf("SlackBot.handleInteractive() = %q %q, want %q %q",
+					attachment.Text, field, tt.wantText, tt.wantField)
+			}
+		})
+	}
+
+	if got := <-requests; got != "env=staging&version=v2" {
+		t.Errorf("request query = %q, want %q", got, "env=staging&version=v2")
+	}
+}
+
+func TestSlackBot_handleInteractive_approval(t *testing.T) {
+	requests := make(chan string, 2)
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- r.URL.RawQuery
+	}))
+	defer target.Close()
+
+	deploy := &domain.Config{
+		CallbackID:         "deploy",
+		Channels:           []string{"general"},
+		TextTemplateString: "deploy",
+		RegexpString:       "^deploy$",
+		Actions:            []string{"master"},
+		Approvers:          []string{"U1", "U2", "U3"},
+		RequiredApprovals:  2,
+		DenySelfApproval:   true,
+		URLTemplateString:  target.URL + "?branch={{.value}}",
+	}
+	deploy.Hydrate()
+
+	s := &SlackBot{
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				return domain.ConfigMap{"deploy": deploy}, nil
+			},
+		},
+		AuditRepository:     &DummyAuditRepository{},
+		ExecutionRepository: &DummyExecutionRepository{},
+		Log:                 zap.NewNop().Sugar(),
+		Session:             NewSession(&DummySessionStore{sessions: map[string]*domain.SessionValue{}}),
+		approvalMutex:       &sync.Mutex{},
+	}
+	sess, err := s.Session.Create([]string{"deploy"}, domain.MessageContext{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		action      string
+		user        string
+		wantText    string
+		wantField   string
+		wantButtons bool
+	}{
+		{
+			name:        "select",
+			action:      actionSelect,
+			user:        "U1",
+			wantText:    "OK to select Master ?\nApprovals 0/2",
+			wantButtons: true,
+		},
+		{
+			name:        "self approval",
+			action:      actionStart,
+			user:        "U1",
+			wantText:    "OK to select Master ?\nApprovals 0/2",
+			wantField:   ":x: @U1: Requester can't approve own request",
+			wantButtons: true,
+		},
+		{
+			name:        "not approver",
+			action:      actionStart,
+			user:        "U4",
+			wantText:    "OK to select Master ?\nApprovals 0/2",
+			wantField:   ":x: @U4: Not allowed to approve",
+			wantButtons: true,
+		},
+		{
+			name:        "first approval",
+			action:      actionStart,
+			user:        "U2",
+			wantText:    "OK to select Master ?\nApprovals 1/2: <@U2>",
+			wantButtons: true,
+		},
+		{
+			name:        "approve twice",
+			action:      actionStart,
+			user:        "U2",
+			wantText:    "OK to select Master ?\nApprovals 1/2: <@U2>",
+			wantField:   ":x: @U2: Already approved by the user",
+			wantButtons: true,
+		},
+		{
+			name:      "quorum",
+			action:    actionStart,
+			user:      "U3",
+			wantText:  "OK to select Master ?\nApprovals 1/2: <@U2>",
+			wantField: ":ok: @U3 confirmed, master, approved by <@U2>, <@U3>",
+		},
+	}
+	original := slack.Message{
+		Msg: slack.Msg{Attachments: []slack.Attachment{{Text: "Select your choice"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := &slack.AttachmentActionCallback{
+				CallbackID:      "deploy@" + sess.ID,
+				Actions:         []slack.AttachmentAction{{Name: tt.action}},
+				OriginalMessage: original,
+			}
+			if tt.action == actionSelect {
+				message.Actions[0].SelectedOptions = []slack.AttachmentActionOption{{Value: "master"}}
+			}
+			message.User.ID = tt.user
+			message.User.Name = tt.user
+
+			w := httptest.NewRecorder()
+			s.handleInteractive(w, message)
+
+			got := slack.Message{}
+			if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			attachment := got.Attachments[0]
+			field := ""
+			if len(attachment.Fields) > 0 {
+				field = attachment.Fields[0].Title
+			}
+			if attachment.Text != tt.wantText || field != tt.wantField || (len(attachment.Actions) > 0) != tt.wantButtons {
+				t.Errorf("SlackBot.handleInteractive() = %q %q buttons %v, want %q %q buttons %v",
+					attachment.Text, field, len(attachment.Actions) > 0, tt.wantText, tt.wantField, tt.wantButtons)
+			}
+			original = got
+		})
+	}
+
+	if got := <-requests; got != "branch=master" {
+		t.Errorf("request query = %q, want %q", got, "branch=master")
+	}
+	if len(requests) != 0 {
+		t.Errorf("request is sent more than once")
+	}
+}
+
+func TestSlackBot_handleInteractive_approvalSteps(t *testing.T) {
+	deploy := &domain.Config{
+		CallbackID:         "deploy",
+		Channels:           []string{"general"},
+		TextTemplateString: "deploy",
+		RegexpString:       "^deploy$",
+		Steps: []domain.Step{
+			{Name: "env", Options: []string{"staging"}},
+			{Name: "version", Options: []string{"v1"}},
+		},
+		Approvers:         []string{"U1", "U2", "U3"},
+		DenySelfApproval:  true,
+		URLTemplateString: "http://localhost",
+	}
+	deploy.Hydrate()
+	s := &SlackBot{
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				return domain.ConfigMap{"deploy": deploy}, nil
+			},
+		},
+		Log:           zap.NewNop().Sugar(),
+		Session:       NewSession(&DummySessionStore{sessions: map[string]*domain.SessionValue{}}),
+		approvalMutex: &sync.Mutex{},
+	}
+	sess, err := s.Session.Create([]string{"deploy"}, domain.MessageContext{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// U1 starts the request, and lets U2 select the last step.
+	tests := []struct {
+		name      string
+		action    string
+		value     string
+		user      string
+		wantField string
+	}{
+		{name: "first step", action: actionSelect, value: "staging", user: "U1"},
+		{name: "last step by other", action: actionSelect, value: "v1", user: "U2"},
+		{name: "requester", action: actionStart, user: "U1", wantField: ":x: @U1: Requester can't approve own request"},
+		{name: "last selector", action: actionStart, user: "U2", wantField: ":x: @U2: Requester can't approve own request"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := &slack.AttachmentActionCallback{
+				CallbackID: "deploy@" + sess.ID,
+				Actions:    []slack.AttachmentAction{{Name: tt.action}},
+				OriginalMessage: slack.Message{
+					Msg: slack.Msg{Attachments: []slack.Attachment{{Text: "Select your choice"}}},
+				},
+			}
+			if tt.action == actionSelect {
+				message.Actions[0].SelectedOptions = []slack.AttachmentActionOption{{Value: tt.value}}
+			}
+			message.User.ID = tt.user
+			message.User.Name = tt.user
+
+			w := httptest.NewRecorder()
+			s.handleInteractive(w, message)
+
+			got := slack.Message{}
+			if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			field := ""
+			if len(got.Attachments[0].Fields) > 0 {
+				field = got.Attachments[0].Fields[0].Title
+			}
+			if field != tt.wantField {
+				t.Errorf("SlackBot.handleInteractive() = %q, want %q", field, tt.wantField)
+			}
+		})
+	}
+	if got, _ := s.Session.Get("deploy@" + sess.ID); got.Requester != "U1" {
+		t.Errorf("requester = %q, want %q", got.Requester, "U1")
+	}
+}
+
+func TestSlackBot_handleMessage_thread(t *testing.T) {
+	posted := make(chan string, 10)
+	slackAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat.postMessage" {
+			w.Write([]byte(`{"ok":false,"error":"not_found"}`))
+			return
+		}
+		r.ParseForm()
+		posted <- r.Form.Get("text") + ":" + r.Form.Get("thread_ts")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"3.0"}`))
+	}))
+	defer slackAPI.Close()
+
+	newConfig := func(ID, threadMatch string, replyInThread bool) *domain.Config {
+		c := &domain.Config{
+			CallbackID:         ID,
+			Channels:           []string{"general"},
+			TextTemplateString: ID,
+			RegexpString:       "^deploy$",
+			Actions:            []string{"master"},
+			URLTemplateString:  "http://localhost",
+			MatchMode:          domain.MatchModeAll,
+			ThreadMatch:        threadMatch,
+			ReplyInThread:      replyInThread,
+		}
+		c.Hydrate()
+		return c
+	}
+	configs := domain.ConfigMap{
+		"channel": newConfig("channel", domain.ThreadMatchAny, false),
+		"thread":  newConfig("thread", domain.ThreadMatchThread, true),
+		"top":     newConfig("top", domain.ThreadMatchTop, true),
+	}
+	s := &SlackBot{
+		API: slack.New("xoxb-token", slack.OptionAPIURL(slackAPI.URL+"/")),
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				return configs, nil
+			},
+		},
+		Log:          zap.NewNop().Sugar(),
+		Session:      NewSession(&DummySessionStore{sessions: map[string]*domain.SessionValue{}}),
+		channelCache: map[string]string{"C1": "general"},
+	}
+
+	tests := []struct {
+		name      string
+		threadTS  string
+		wantPosts []string
+	}{
+		{
+			name:      "top-level message",
+			wantPosts: []string{"channel:", "top:2.0"},
+		},
+		{
+			name:      "thread parent",
+			threadTS:  "2.0",
+			wantPosts: []string{"channel:", "top:2.0"},
+		},
+		{
+			name:      "thread reply",
+			threadTS:  "1.0",
+			wantPosts: []string{"channel:", "thread:1.0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &slack.Msg{Channel: "C1", User: "U1", Text: "deploy", Timestamp: "2.0", ThreadTimestamp: tt.threadTS}
+			if err := s.handleMessage(msg, "B1"); err != nil {
+				t.Fatal(err)
+			}
+			got := []string{}
+			for len(posted) > 0 {
+				got = append(got, <-posted)
+			}
+			if !reflect.DeepEqual(got, tt.wantPosts) {
+				t.Errorf("SlackBot.handleMessage() posted %v, want %v", got, tt.wantPosts)
+			}
+		})
+	}
+}
+
+func TestSlackBot_handleMessage_approvalWithoutActions(t *testing.T) {
+	requests := make(chan string, 1)
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- r.URL.Path
+	}))
+	defer target.Close()
+
+	posted := make(chan string, 10)
+	slackAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat.postMessage" {
+			w.Write([]byte(`{"ok":false,"error":"not_found"}`))
+			return
+		}
+		r.ParseForm()
+		posted <- r.Form.Get("text")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"3.0"}`))
+	}))
+	defer slackAPI.Close()
+
+	// Saved before approvals were validated.
+	deploy := &domain.Config{
+		CallbackID:         "deploy",
+		Channels:           []string{"general"},
+		TextTemplateString: "deploy",
+		RegexpString:       "^deploy$",
+		Approvers:          []string{"U_BOSS"},
+		RequiredApprovals:  2,
+		DenySelfApproval:   true,
+		URLTemplateString:  target.URL,
+	}
+	deploy.Hydrate()
+	s := &SlackBot{
+		API: slack.New("xoxb-token", slack.OptionAPIURL(slackAPI.URL+"/")),
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				return domain.ConfigMap{"deploy": deploy}, nil
+			},
+		},
+		AuditRepository:     &DummyAuditRepository{},
+		ExecutionRepository: &DummyExecutionRepository{},
+		Log:                 zap.NewNop().Sugar(),
+		Session:             NewSession(&DummySessionStore{sessions: map[string]*domain.SessionValue{}}),
+		channelCache:        map[string]string{"C1": "general"},
+	}
+
+	msg := &slack.Msg{Channel: "C1", User: "U1", Text: "deploy", Timestamp: "2.0"}
+	if err := s.handleMessage(msg, "B1"); err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) > 0 {
+		t.Errorf("SlackBot.handleMessage() sent %s without approvals", <-requests)
+	}
+	if got, want := <-posted, ":x: Approval requires Actions, Steps or Options URL"; got != want {
+		t.Errorf("SlackBot.handleMessage() posted %q, want %q", got, want)
+	}
+}
+
+func TestSlackBot_handleInteractive_cancel(t *testing.T) {
+	ephemerals := make(chan string, 10)
+	slackAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		ephemerals <- r.Form.Get("user")
+		w.Write([]byte(`{"ok":true,"message_ts":"1.0"}`))
+	}))
+	defer slackAPI.Close()
+
+	newConfig := func(ID string, approvers []string) *domain.Config {
+		c := &domain.Config{
+			CallbackID:         ID,
+			Channels:           []string{"general"},
+			TextTemplateString: "deploy",
+			RegexpString:       "^deploy$",
+			Actions:            []string{"master"},
+			Approvers:          approvers,
+			AllowedUsers:       []string{"U1", "U3"},
+			URLTemplateString:  "http://localhost",
+		}
+		c.Hydrate()
+		return c
+	}
+	store := &DummySessionStore{sessions: map[string]*domain.SessionValue{}}
+	s := &SlackBot{
+		API: slack.New("xoxb-token", slack.OptionAPIURL(slackAPI.URL+"/")),
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				return domain.ConfigMap{
+					"approval": newConfig("approval", []string{"U2"}),
+					"anyone":   newConfig("anyone", nil),
+				}, nil
+			},
+		},
+		Log:     zap.NewNop().Sugar(),
+		Session: NewSession(store),
+	}
+
+	tests := []struct {
+		name       string
+		config     string
+		requester  string
+		user       string
+		wantCancel bool
+	}{
+		{name: "allowed before select", config: "approval", user: "U3", wantCancel: true},
+		{name: "not allowed before select", config: "approval", user: "U4"},
+		{name: "requester", config: "approval", requester: "U1", user: "U1", wantCancel: true},
+		{name: "approver", config: "approval", requester: "U1", user: "U2", wantCancel: true},
+		{name: "allowed but not approver", config: "approval", requester: "U1", user: "U3"},
+		{name: "allowed without approvers", config: "anyone", requester: "U1", user: "U3", wantCancel: true},
+		{name: "not allowed without approvers", config: "anyone", requester: "U1", user: "U4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.sessions[tt.name] = &domain.SessionValue{ID: tt.name, Requester: tt.requester}
+			message := &slack.AttachmentActionCallback{
+				CallbackID: tt.config + "@" + tt.name,
+				Actions:    []slack.AttachmentAction{{Name: actionCancel}},
+				OriginalMessage: slack.Message{
+					Msg: slack.Msg{Attachments: []slack.Attachment{{Text: "Select your choice"}}},
+				},
+			}
+			message.User.ID = tt.user
+			message.User.Name = tt.user
+
+			w := httptest.NewRecorder()
+			s.handleInteractive(w, message)
+
+			canceled := w.Body.Len() > 0
+			if canceled != tt.wantCancel {
+				t.Errorf("SlackBot.handleInteractive() canceled = %v, want %v", canceled, tt.wantCancel)
+			}
+			if !tt.wantCancel {
+				if got := <-ephemerals; got != tt.user {
+					t.Errorf("SlackBot.handleInteractive() ephemeral to %q, want %q", got, tt.user)
+				}
+			}
+		})
+	}
+}
+
+func TestSlackBot_handleInteractive_retry(t *testing.T) {
+	var requests int32
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("deployed"))
+	}))
+	defer target.Close()
+
+	// Field of the message updated by API.
+	updates := make(chan string, 1)
+	slackAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		attachments := []slack.Attachment{}
+		json.Unmarshal([]byte(r.Form.Get("attachments")), &attachments)
+		if len(attachments) > 0 && len(attachments[0].Fields) > 0 {
+			updates <- r.URL.Path + " " + r.Form.Get("ts") + " " + attachments[0].Fields[0].Title
+		}
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"1.0"}`))
+	}))
+	defer slackAPI.Close()
+
+	deploy := &domain.Config{
+		CallbackID:         "deploy",
+		Channels:           []string{"general"},
+		TextTemplateString: "deploy",
+		RegexpString:       "^deploy$",
+		Actions:            []string{"master"},
+		URLTemplateString:  target.URL,
+		RetryMaxAttempts:   3,
+		RetryBackoffString: "1ms",
+	}
+	deploy.Hydrate()
+	s := &SlackBot{
+		API: slack.New("xoxb-token", slack.OptionAPIURL(slackAPI.URL+"/")),
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				return domain.ConfigMap{"deploy": deploy}, nil
+			},
+		},
+		AuditRepository:     &DummyAuditRepository{},
+		ExecutionRepository: &DummyExecutionRepository{},
+		Log:                 zap.NewNop().Sugar(),
+		Session:             NewSession(&DummySessionStore{sessions: map[string]*domain.SessionValue{}}),
+	}
+	sess, err := s.Session.Create([]string{"deploy"}, domain.MessageContext{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := &slack.AttachmentActionCallback{
+		CallbackID: "deploy@" + sess.ID,
+		Actions: []slack.AttachmentAction{
+			{Name: actionSelect, SelectedOptions: []slack.AttachmentActionOption{{Value: "master"}}},
+		},
+		MessageTs: "1.0",
+		OriginalMessage: slack.Message{
+			Msg: slack.Msg{Attachments: []slack.Attachment{{Text: "Select your choice"}}},
+		},
+	}
+	message.Channel.ID = "C1"
+	message.User.Name = "alice"
+
+	w := httptest.NewRecorder()
+	s.handleInteractive(w, message)
+
+	// Responds before the retries, the result is updated by API.
+	got := &slack.Message{}
+	if err := json.NewDecoder(w.Body).Decode(got); err != nil {
+		t.Fatal(err)
+	}
+	if field := got.Attachments[0].Fields[0]; field.Value != ":hourglass_flowing_sand: Sending..." {
+		t.Errorf("SlackBot.handleInteractive() = %q, want sending", field.Value)
+	}
+	want := "/chat.update 1.0 :ok: @alice start this, master"
+	select {
+	case got := <-updates:
+		if got != want {
+			t.Errorf("SlackBot.handleInteractive() updated %q, want %q", got, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("SlackBot.handleInteractive() is not updated, want %q", want)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("requested %d times, want 2", got)
+	}
+}
@@ -0,0 +1,198 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/nlopes/slack"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	socketModeHello        = "hello"
+	socketModeDisconnect   = "disconnect"
+	socketModeEventsAPI    = "events_api"
+	socketModeInteractive  = "interactive"
+	socketModeSlashCommand = "slash_commands"
+)
+
+const (
+	defaultSlackAPIURL        = "https://slack.com/api/"
+	socketModeReconnectWait   = 5 * time.Second
+	socketModeConnectionsOpen = "apps.connections.open"
+)
+
+type socketModeEnvelope struct {
+	Type       string          `json:"type"`
+	EnvelopeID string          `json:"envelope_id"`
+	Payload    json.RawMessage `json:"payload"`
+	Reason     string          `json:"reason"`
+}
+
+// socketModeInteraction is the interactive payload, only interactive_message of attachments is handled.
+type socketModeInteraction struct {
+	Type slack.InteractionType `json:"type"`
+	slack.AttachmentActionCallback
+}
+
+type socketModeAck struct {
+	EnvelopeID string `json:"envelope_id"`
+}
+
+type connectionsOpenResponse struct {
+	OK    bool   `json:"ok"`
+	URL   string `json:"url"`
+	Error string `json:"error"`
+}
+
+// SocketMode receives events and interactive messages over outbound websocket,
+// no inbound HTTP port is needed.
+type SocketMode struct {
+	AppToken string // xapp- app level token
+	APIURL   string
+	Bot      *SlackBot
+	Log      *zap.SugaredLogger
+	inflight *sync.WaitGroup
+}
+
+func NewSocketMode(AppToken string, Bot *SlackBot, Log *zap.SugaredLogger) *SocketMode {
+	return &SocketMode{
+		AppToken: AppToken,
+		APIURL:   defaultSlackAPIURL,
+		Bot:      Bot,
+		Log:      Log,
+		inflight: &sync.WaitGroup{},
+	}
+}
+
+// Run keeps the connection, reconnects when it's closed.
+func (m *SocketMode) Run() error {
+	for {
+		url, err := m.connectionsOpen()
+		if err != nil {
+			m.Log.Errorw("Socket Mode connection open failed", zap.Error(err))
+		} else if err := m.serve(url); err != nil {
+			m.Log.Errorw("Socket Mode connection closed", zap.Error(err))
+		}
+		time.Sleep(socketModeReconnectWait)
+	}
+}
+
+// connectionsOpen returns a websocket URL to connect.
+func (m *SocketMode) connectionsOpen() (string, error) {
+	req, err := http.NewRequest("POST", m.APIURL+socketModeConnectionsOpen, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot make request")
+	}
+	req.Header.Set("Authorization", "Bearer "+m.AppToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "apps.connections.open failed")
+	}
+	defer resp.Body.Close()
+
+	var result connectionsOpenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", errors.Wrap(err, "Invalid apps.connections.open response")
+	}
+	if !result.OK {
+		return "", errors.Errorf("apps.connections.open failed: %s", result.Error)
+	}
+	return result.URL, nil
+}
+
+// serve reads envelopes until disconnect, and waits for processing envelopes.
+func (m *SocketMode) serve(url string) error {
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		return errors.Wrap(err, "Dial failed")
+	}
+	defer conn.Close()
+	defer m.inflight.Wait()
+
+	for {
+		var envelope socketModeEnvelope
+		if err := conn.ReadJSON(&envelope); err != nil {
+			return errors.Wrap(err, "Read failed")
+		}
+
+		switch envelope.Type {
+		case socketModeHello:
+			m.Log.Info("Socket Mode connected")
+			continue
+		case socketModeDisconnect:
+			m.Log.Infow("Socket Mode disconnect requested", zap.String("reason", envelope.Reason))
+			return nil
+		}
+
+		// Ack first, Slack retries if it takes over 3 seconds.
+		if err := conn.WriteJSON(&socketModeAck{EnvelopeID: envelope.EnvelopeID}); err != nil {
+			return errors.Wrap(err, "Ack failed")
+		}
+
+		m.inflight.Add(1)
+		go func() {
+			defer m.inflight.Done()
+			// A broken payload must not stop the bot, HTTP handlers are recovered by middleware.
+			defer func() {
+				if r := recover(); r != nil {
+					m.Log.Errorw("Recover from panic", zap.Any("panic", r), zap.String("type", envelope.Type))
+				}
+			}()
+			m.dispatch(&envelope)
+		}()
+	}
+}
+
+// dispatch passes the payload to the bot, websocket is authenticated by app token.
+func (m *SocketMode) dispatch(envelope *socketModeEnvelope) {
+	switch envelope.Type {
+	case socketModeEventsAPI:
+		var event eventsAPIEnvelope
+		if err := json.Unmarshal(envelope.Payload, &event); err != nil {
+			m.Log.Errorw("Failed to decode event", zap.Error(err))
+			return
+		}
+		if event.Type == eventsCallback {
+			m.Bot.handleEventCallback(&event, "")
+		}
+	case socketModeInteractive:
+		var message socketModeInteraction
+		if err := json.Unmarshal(envelope.Payload, &message); err != nil {
+			m.Log.Errorw("Failed to decode interactive message", zap.Error(err))
+			return
+		}
+		if message.Type != slack.InteractionTypeInteractionMessage || !isAttachmentAction(&message.AttachmentActionCallback) {
+			m.Log.Infow("Ignore interactive message", zap.String("type", string(message.Type)))
+			return
+		}
+		// The response is not delivered, the bot updates the message by API.
+		m.Bot.handleInteractive(&discardResponseWriter{header: http.Header{}}, &message.AttachmentActionCallback)
+	case socketModeSlashCommand:
+		var cmd slashCommand
+		if err := json.Unmarshal(envelope.Payload, &cmd); err != nil {
+			m.Log.Errorw("Failed to decode slash command", zap.Error(err))
+			return
+		}
+		if err := m.Bot.handleCommand(&cmd); err != nil {
+			m.Log.Errorw("Handle slash command failed", zap.Error(err), zap.String("command", cmd.Command))
+		}
+	default:
+		m.Log.Infow("Ignore envelope", zap.String("type", envelope.Type))
+	}
+}
+
+// discardResponseWriter is http.ResponseWriter for the response nobody receives.
+type discardResponseWriter struct {
+	header http.Header
+}
+
+func (d *discardResponseWriter) Header() http.Header         { return d.header }
+func (d *discardResponseWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (d *discardResponseWriter) WriteHeader(int)             {}
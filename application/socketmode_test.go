@@ -0,0 +1,198 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/juntaki/firestarter/domain"
+	"go.uber.org/zap"
+)
+
+type DummySessionStore struct {
+	sessions map[string]*domain.SessionValue
+}
+
+func (d *DummySessionStore) Get(ID string) (*domain.SessionValue, bool) {
+	sess, ok := d.sessions[ID]
+	if !ok {
+		return nil, false
+	}
+	return sess.Copy(), true
+}
+func (d *DummySessionStore) Set(ID string, sess *domain.SessionValue, expire time.Duration) error {
+	d.sessions[ID] = sess.Copy()
+	return nil
+}
+func (d *DummySessionStore) DeleteExpired() error {
+	return nil
+}
+
+// fakeSocketModeServer sends envelopes after hello, and collects acks until disconnect.
+func fakeSocketModeServer(t *testing.T, appToken string, envelopes []string, acks chan<- string) *httptest.Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	mux.HandleFunc("/api/apps.connections.open", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+appToken {
+			json.NewEncoder(w).Encode(&connectionsOpenResponse{OK: false, Error: "invalid_auth"})
+			return
+		}
+		json.NewEncoder(w).Encode(&connectionsOpenResponse{
+			OK:  true,
+			URL: "ws" + strings.TrimPrefix(server.URL, "http") + "/link",
+		})
+	})
+	mux.HandleFunc("/link", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+
+		conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"hello"}`))
+		for _, e := range envelopes {
+			conn.WriteMessage(websocket.TextMessage, []byte(e))
+			var ack socketModeAck
+			if err := conn.ReadJSON(&ack); err != nil {
+				t.Error(err)
+				return
+			}
+			acks <- ack.EnvelopeID
+		}
+		conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"disconnect","reason":"refresh_requested"}`))
+		close(acks)
+	})
+	return server
+}
+
+func TestSocketMode(t *testing.T) {
+	requested := make(chan string, 1)
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested <- r.URL.Query().Get("env")
+	}))
+	defer target.Close()
+
+	config := &domain.Config{
+		CallbackID:         "deploy",
+		Channels:           []string{"general"},
+		TextTemplateString: "deploy",
+		RegexpString:       "deploy",
+		Actions:            []string{"production"},
+		URLTemplateString:  target.URL + "/?env={{.value}}",
+	}
+	config.Hydrate()
+
+	executions := &DummyExecutionRepository{}
+	bot := &SlackBot{
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				return domain.ConfigMap{config.CallbackID: config}, nil
+			},
+		},
+		AuditRepository:     &DummyAuditRepository{},
+		ExecutionRepository: executions,
+		Log:                 zap.NewNop().Sugar(),
+		Session: NewSession(&DummySessionStore{
+			sessions: map[string]*domain.SessionValue{
+				"sess": {Matched: []string{"deploy"}, ID: "sess"},
+			},
+		}),
+		eventDeduper: newEventDeduper(),
+	}
+
+	interactive := `{"envelope_id":"env-1","type":"interactive","payload":{
+		"type":"interactive_message",
+		"callback_id":"deploy@sess",
+		"actions":[{"name":"select","type":"select","selected_options":[{"value":"production"}]}],
+		"channel":{"id":"C1","name":"general"},
+		"user":{"id":"U1","name":"alice"},
+		"original_message":{"text":"deploy","attachments":[{"text":"Select your choice"}]}
+	}}`
+	event := `{"envelope_id":"env-2","type":"events_api","payload":{
+		"type":"event_callback","event_id":"Ev1","event":{"type":"reaction_added"}
+	}}`
+
+	acks := make(chan string, 2)
+	server := fakeSocketModeServer(t, "xapp-token", []string{interactive, event}, acks)
+	defer server.Close()
+
+	m := NewSocketMode("xapp-token", bot, zap.NewNop().Sugar())
+	m.APIURL = server.URL + "/api/"
+	url, err := m.connectionsOpen()
+	if err != nil {
+		t.Fatalf("SocketMode.connectionsOpen() error = %v", err)
+	}
+	if err := m.serve(url); err != nil {
+		t.Fatalf("SocketMode.serve() error = %v", err)
+	}
+
+	got := []string{}
+	for ack := range acks {
+		got = append(got, ack)
+	}
+	if strings.Join(got, ",") != "env-1,env-2" {
+		t.Errorf("SocketMode acks = %v, want [env-1 env-2]", got)
+	}
+	if env := <-requested; env != "production" {
+		t.Errorf("SocketMode request env = %v, want production", env)
+	}
+	if len(executions.executions) != 1 || executions.executions[0].User != "alice" {
+		t.Errorf("SocketMode executions = %v, want one by alice", executions.executions)
+	}
+
+	m.AppToken = "invalid"
+	if _, err := m.connectionsOpen(); err == nil {
+		t.Errorf("SocketMode.connectionsOpen() want error")
+	}
+}
+
+func TestSocketMode_invalidInteractive(t *testing.T) {
+	var loads int32
+	bot := &SlackBot{
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				atomic.AddInt32(&loads, 1)
+				panic("broken config")
+			},
+		},
+		Log: zap.NewNop().Sugar(),
+	}
+
+	envelopes := []string{
+		// Shortcut and block actions have the callback ID, but no attachment actions.
+		`{"envelope_id":"env-1","type":"interactive","payload":{"type":"shortcut","callback_id":"deploy@sess"}}`,
+		`{"envelope_id":"env-2","type":"interactive","payload":{"type":"block_actions","callback_id":"deploy@sess",
+			"actions":[{"action_id":"a"}],"original_message":{"attachments":[{"text":"x"}]}}}`,
+		`{"envelope_id":"env-3","type":"interactive","payload":{"type":"interactive_message","callback_id":"deploy@sess",
+			"actions":[{"name":"select"}],"original_message":{"attachments":[{"text":"x"}]}}}`,
+		`{"envelope_id":"env-4","type":"interactive","payload":{"type":"interactive_message","callback_id":"deploy",
+			"actions":[{"name":"start"}],"original_message":{"attachments":[{"text":"x"}]}}}`,
+		// Valid, but panics in the bot.
+		`{"envelope_id":"env-5","type":"interactive","payload":{"type":"interactive_message","callback_id":"deploy@sess",
+			"actions":[{"name":"start"}],"original_message":{"attachments":[{"text":"x"}]}}}`,
+	}
+	acks := make(chan string, len(envelopes))
+	server := fakeSocketModeServer(t, "xapp-token", envelopes, acks)
+	defer server.Close()
+
+	m := NewSocketMode("xapp-token", bot, zap.NewNop().Sugar())
+	m.APIURL = server.URL + "/api/"
+	url, err := m.connectionsOpen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.serve(url); err != nil {
+		t.Fatalf("SocketMode.serve() error = %v", err)
+	}
+	for range acks {
+	}
+	if got := atomic.LoadInt32(&loads); got != 1 {
+		t.Errorf("SocketMode handled %d interactive messages, want 1", got)
+	}
+}
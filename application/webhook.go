@@ -0,0 +1,82 @@
+package application
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/juntaki/firestarter/domain"
+	"go.uber.org/zap"
+)
+
+const webhookUser = "webhook"
+
+type webhookResponse struct {
+	OK        bool   `json:"ok"`
+	SessionID string `json:"session_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// WebhookHandler receives POST /hooks/{configID} from external systems,
+// JSON payload is passed to the templates as .payload and the message is posted to the channels.
+func (s *SlackBot) WebhookHandler(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		s.Log.Errorf("Failed to read request body: %s", err)
+		s.webhookResponse(w, http.StatusInternalServerError, &webhookResponse{Error: "failed to read body"})
+		return
+	}
+
+	// Load config
+	config, err := s.ConfigRepository.GetConfigList()
+	if err != nil {
+		s.Log.Error("Get config map failed")
+		s.webhookResponse(w, http.StatusInternalServerError, &webhookResponse{Error: "failed to get config"})
+		return
+	}
+	c := config.FindByCallbackID(chi.URLParam(r, "configID"))
+	// Do not tell whether the config exists.
+	if c == nil || !c.HasWebhookAuth() ||
+		!c.VerifyWebhook(r.Header.Get("Authorization"), r.Header.Get("X-Hub-Signature-256"), body) {
+		s.Log.Errorw("Invalid webhook request", zap.String("configID", chi.URLParam(r, "configID")))
+		s.webhookResponse(w, http.StatusUnauthorized, &webhookResponse{Error: "unauthorized"})
+		return
+	}
+
+	var payload interface{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &payload); err != nil {
+			s.webhookResponse(w, http.StatusBadRequest, &webhookResponse{Error: "payload is invalid json"})
+			return
+		}
+	}
+	s.Log.Infow("Webhook Match", zap.String("id", c.CallbackID))
+
+	// Create Session for webhook, nothing is matched.
+	msgContext := domain.MessageContext{UserName: webhookUser, Time: time.Now()}
+	sess, err := s.Session.CreateWithPayload([]string{}, msgContext, payload)
+	if err != nil {
+		s.Log.Errorw("Create session failed", zap.Error(err))
+		s.webhookResponse(w, http.StatusInternalServerError, &webhookResponse{Error: "failed to create session"})
+		return
+	}
+	s.Log.Infow("Create Session", zap.String("SessionID", sess.ID))
+
+	replier := s.channelsReplier(c.Channels)
+	err = s.startRequest(c, sess, replier, strings.Join(c.Channels, ","), webhookUser)
+	if err != nil {
+		s.Log.Errorw("Webhook request failed", zap.Error(err), zap.String("id", c.CallbackID))
+		s.webhookResponse(w, http.StatusInternalServerError, &webhookResponse{SessionID: sess.ID, Error: err.Error()})
+		return
+	}
+	s.webhookResponse(w, http.StatusOK, &webhookResponse{OK: true, SessionID: sess.ID})
+}
+
+func (s *SlackBot) webhookResponse(w http.ResponseWriter, status int, resp *webhookResponse) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
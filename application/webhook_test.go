@@ -0,0 +1,141 @@
+package application
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/juntaki/firestarter/domain"
+	"github.com/nlopes/slack"
+	"go.uber.org/zap"
+)
+
+func TestSlackBot_WebhookHandler(t *testing.T) {
+	posted := make(chan string, 10)
+	slackAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		posted <- r.Form.Get("channel") + ":" + r.Form.Get("text")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"1"}`))
+	}))
+	defer slackAPI.Close()
+
+	config := &domain.Config{
+		CallbackID:         "rollback",
+		Channels:           []string{"alerts", "ops"},
+		TextTemplateString: "Alert: {{.payload.alert}}",
+		Actions:            []string{"rollback", "ignore"},
+		URLTemplateString:  "http://example.com/{{.payload.service}}/{{.value}}",
+		Secrets: map[string]string{
+			domain.WebhookTokenSecretKey: "token",
+			domain.WebhookHMACSecretKey:  "secret",
+		},
+	}
+	config.Hydrate()
+
+	store := &DummySessionStore{sessions: map[string]*domain.SessionValue{}}
+	s := &SlackBot{
+		API: slack.New("xoxb-token", slack.OptionAPIURL(slackAPI.URL+"/")),
+		ConfigRepository: &DummyConfigRepository{
+			dummyGetConfigList: func() (domain.ConfigMap, error) {
+				return domain.ConfigMap{config.CallbackID: config}, nil
+			},
+		},
+		Log:     zap.NewNop().Sugar(),
+		Session: NewSession(store),
+	}
+	router := chi.NewRouter()
+	router.Post("/hooks/{configID}", s.WebhookHandler)
+
+	body := `{"alert":"high cpu","service":"api"}`
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(body))
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name      string
+		path      string
+		body      string
+		header    map[string]string
+		wantCode  int
+		wantPosts []string
+	}{
+		{
+			name:      "token",
+			path:      "/hooks/rollback",
+			body:      body,
+			header:    map[string]string{"Authorization": "Bearer token"},
+			wantCode:  http.StatusOK,
+			wantPosts: []string{"alerts:Alert: high cpu", "ops:Alert: high cpu"},
+		},
+		{
+			name:      "signature",
+			path:      "/hooks/rollback",
+			body:      body,
+			header:    map[string]string{"X-Hub-Signature-256": signature},
+			wantCode:  http.StatusOK,
+			wantPosts: []string{"alerts:Alert: high cpu", "ops:Alert: high cpu"},
+		},
+		{
+			name:     "invalid token",
+			path:     "/hooks/rollback",
+			body:     body,
+			header:   map[string]string{"Authorization": "Bearer invalid"},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "invalid signature",
+			path:     "/hooks/rollback",
+			body:     `{"alert":"forged"}`,
+			header:   map[string]string{"X-Hub-Signature-256": signature},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "not found",
+			path:     "/hooks/unknown",
+			body:     body,
+			header:   map[string]string{"Authorization": "Bearer token"},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "invalid json",
+			path:     "/hooks/rollback",
+			body:     "alert",
+			header:   map[string]string{"Authorization": "Bearer token"},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", tt.path, strings.NewReader(tt.body))
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != tt.wantCode {
+				t.Fatalf("SlackBot.WebhookHandler() = %d %s, want %d", w.Code, w.Body.String(), tt.wantCode)
+			}
+
+			posts := []string{}
+			for len(posted) > 0 {
+				posts = append(posts, <-posted)
+			}
+			if strings.Join(posts, "\n") != strings.Join(tt.wantPosts, "\n") {
+				t.Errorf("SlackBot.WebhookHandler() posts = %v, want %v", posts, tt.wantPosts)
+			}
+		})
+	}
+
+	// Payload is kept in the session for the selected action.
+	for _, sess := range store.sessions {
+		url, err := config.URLCompile(&domain.SessionValue{Value: "rollback", Payload: sess.Payload})
+		if err != nil || url != "http://example.com/api/rollback" {
+			t.Errorf("Config.URLCompile() = %v, %v", url, err)
+		}
+	}
+}
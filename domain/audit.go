@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/rs/xid"
+)
+
+const (
+	AuditActionSetConfig     = "config.set"
+	AuditActionDeleteConfig  = "config.delete"
+	AuditActionRestoreConfig = "config.restore"
+	AuditActionTrigger       = "action.trigger"
+)
+
+const AuditOutcomeSuccess = "success"
+
+type AuditEvent struct {
+	ID       string
+	Time     time.Time
+	Actor    string
+	Action   string
+	ConfigID string
+	Diff     []string // secrets are masked
+	Detail   string
+	Outcome  string // success or error message
+}
+
+type AuditFilter struct {
+	ConfigID string
+	Since    time.Time // ignored if zero
+	Until    time.Time // ignored if zero
+}
+
+func (f *AuditFilter) Match(event *AuditEvent) bool {
+	if f.ConfigID != "" && f.ConfigID != event.ConfigID {
+		return false
+	}
+	if !f.Since.IsZero() && event.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && event.Time.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+type AuditRepository interface {
+	AddAuditEvent(*AuditEvent) error
+	ListAuditEvents(filter *AuditFilter) ([]*AuditEvent, error)
+}
+
+func NewAuditEvent(actor, action, configID string) *AuditEvent {
+	return &AuditEvent{
+		ID:       xid.New().String(),
+		Time:     time.Now(),
+		Actor:    actor,
+		Action:   action,
+		ConfigID: configID,
+	}
+}
+
+// SetOutcome sets success, or the error message.
+func (e *AuditEvent) SetOutcome(err error) {
+	if err != nil {
+		e.Outcome = err.Error()
+	} else {
+		e.Outcome = AuditOutcomeSuccess
+	}
+}
+
+// DiffConfig returns changed fields of the config, before or after can be nil.
+// Secret values are masked.
+func DiffConfig(before, after *Config) []string {
+	if before == nil {
+		before = &Config{}
+	}
+	if after == nil {
+		after = &Config{}
+	}
+	mask := func(s string) string {
+		return after.ExecSecretValueMask(before.ExecSecretValueMask(s))
+	}
+
+	diff := []string{}
+	bv := reflect.ValueOf(before).Elem()
+	av := reflect.ValueOf(after).Elem()
+	for i := 0; i < bv.NumField(); i++ {
+		field := bv.Type().Field(i)
+		if field.Name == "Secrets" || isCompiledField(field.Type) {
+			continue
+		}
+		b := marshalField(bv.Field(i))
+		a := marshalField(av.Field(i))
+		if string(b) != string(a) {
+			// Mask before encoding, JSON escapes secrets with quotes or <>&.
+			diff = append(diff, fmt.Sprintf("%s: %s -> %s", field.Name,
+				marshalField(maskStrings(bv.Field(i), mask)), marshalField(maskStrings(av.Field(i), mask))))
+		}
+	}
+
+	keys := []string{}
+	for k := range before.Secrets {
+		keys = append(keys, k)
+	}
+	for k := range after.Secrets {
+		if _, ok := before.Secrets[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		b, bok := before.Secrets[k]
+		a, aok := after.Secrets[k]
+		switch {
+		case !bok:
+			diff = append(diff, fmt.Sprintf("Secrets.%s: added", k))
+		case !aok:
+			diff = append(diff, fmt.Sprintf("Secrets.%s: removed", k))
+		case b != a && a != SercretValueMask:
+			diff = append(diff, fmt.Sprintf("Secrets.%s: changed", k))
+		}
+	}
+	return diff
+}
+
+// isCompiledField returns true for the fields compiled by Hydrate.
+func isCompiledField(t reflect.Type) bool {
+	switch {
+	case t.Kind() == reflect.Ptr || t.Kind() == reflect.Interface:
+		return true
+	case t.Kind() == reflect.Map && t.Elem().Kind() == reflect.Ptr:
+		return true
+	case t == reflect.TypeOf(time.Duration(0)):
+		return true
+	}
+	return false
+}
+
+// maskStrings returns the copy of the value whose strings are masked, including in slices, maps and structs.
+func maskStrings(v reflect.Value, mask func(string) string) reflect.Value {
+	switch v.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(mask(v.String())).Convert(v.Type())
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		masked := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			masked.Index(i).Set(maskStrings(v.Index(i), mask))
+		}
+		return masked
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		masked := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, k := range v.MapKeys() {
+			masked.SetMapIndex(maskStrings(k, mask), maskStrings(v.MapIndex(k), mask))
+		}
+		return masked
+	case reflect.Struct:
+		masked := reflect.New(v.Type()).Elem()
+		masked.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if masked.Field(i).CanSet() {
+				masked.Field(i).Set(maskStrings(v.Field(i), mask))
+			}
+		}
+		return masked
+	}
+	return v
+}
+
+// marshalField encodes the field as JSON, nil and empty collections are the same.
+// <>& are not escaped to keep the mask readable.
+func marshalField(v reflect.Value) []byte {
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice:
+		if v.Len() == 0 {
+			return []byte("null")
+		}
+	}
+	buf := new(bytes.Buffer)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(v.Interface())
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
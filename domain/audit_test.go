@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDiffConfig_secrets(t *testing.T) {
+	secret := `p"w<d>&\1`
+	before := &Config{
+		URLTemplateString: "http://localhost",
+		Secrets:           map[string]string{"TOKEN": secret},
+	}
+	after := &Config{
+		URLTemplateString: "http://localhost/?t=" + secret,
+		Headers:           map[string]string{"Authorization": "Bearer " + secret},
+		Steps:             []Step{{Name: "env", Options: []string{secret}}},
+		Secrets:           map[string]string{"TOKEN": SercretValueMask},
+	}
+
+	encoded, _ := json.Marshal(secret)
+	diff := strings.Join(DiffConfig(before, after), "\n")
+	for _, leaked := range []string{secret, string(encoded[1 : len(encoded)-1]), "w<d>", `w\u003cd`} {
+		if strings.Contains(diff, leaked) {
+			t.Errorf("DiffConfig() has the secret %q:\n%s", leaked, diff)
+		}
+	}
+	for _, want := range []string{
+		`URLTemplateString: "http://localhost" -> "http://localhost/?t=<SecretValue>"`,
+		`Headers: null -> {"Authorization":"Bearer <SecretValue>"}`,
+		`Steps: null -> [{"Name":"env","Text":"","Options":["<SecretValue>"]}]`,
+	} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("DiffConfig() = \n%s\nwant %s", diff, want)
+		}
+	}
+}
@@ -2,6 +2,17 @@ package domain
 
 type Channels []string
 
+// Member is a user or user group of the workspace.
+type Member struct {
+	ID    string
+	Name  string
+	Group bool
+}
+
+type Members []Member
+
 type ChatRepository interface {
 	GetChannels() (Channels, error)
+	// GetMembers returns users and user groups, to choose who can trigger or approve.
+	GetMembers() (Members, error)
 }
@@ -2,31 +2,164 @@ package domain
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
 	"regexp"
+	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"net/url"
 
 	"github.com/pkg/errors"
+	"github.com/robfig/cron"
 	"github.com/rs/xid"
 	"gopkg.in/go-playground/validator.v9"
 )
 
 var SercretValueMask = "<SecretValue>"
 
+// Secrets to authenticate inbound webhook, the webhook is enabled if any of them is set.
+const (
+	WebhookTokenSecretKey = "WEBHOOK_TOKEN"  // Authorization: Bearer <token>
+	WebhookHMACSecretKey  = "WEBHOOK_SECRET" // X-Hub-Signature-256: sha256=<HMAC-SHA256 of body>
+)
+
+const (
+	DefaultMethod       = "POST"
+	DefaultContentType  = "application/json"
+	DefaultTimeout      = 30 * time.Second
+	DefaultRetryBackoff = 1 * time.Second
+)
+
+// DefaultRetryStatusCodes are retried if RetryStatusCodes is empty.
+var DefaultRetryStatusCodes = []int{502, 503, 504}
+
+var headerKeyRegexp = regexp.MustCompile("^[A-Za-z0-9-]+$")
+
 type ConfigRepository interface {
 	GetConfigList() (ConfigMap, error)
 	GetConfig(ID string) (*Config, error)
 	SetConfig(*Config) error
 	IsExist(ID string) (bool, error)
 	DeleteConfig(ID string) error
+	// RestoreConfigList replaces all configs, or merges them if merge is true.
+	RestoreConfigList(configs ConfigMap, merge bool) error
+	// Revision changes when configs are modified, to reload the cached ones.
+	Revision() uint64
 }
 
+// Match modes, how the config is fired when other configs match the same message.
+const (
+	MatchModeFirst     = "first"     // fire the highest priority one, default
+	MatchModeAll       = "all"       // fire with lower priority ones
+	MatchModeExclusive = "exclusive" // fire only if no other config matches
+)
+
+// Thread matches, which messages in a channel trigger the config.
+const (
+	ThreadMatchAny    = ""       // top-level messages and thread replies, default
+	ThreadMatchThread = "thread" // thread replies only
+	ThreadMatchTop    = "top"    // top-level messages only
+)
+
 type ConfigMap map[string]*Config
 
-func (q *ConfigMap) FindMatched(channel, text string) *Config {
+// Sorted returns configs in order of priority, higher first, then callback ID.
+func (q *ConfigMap) Sorted() []*Config {
+	configs := make([]*Config, 0, len(*q))
+	for _, config := range *q {
+		configs = append(configs, config)
+	}
+	sort.Slice(configs, func(i, j int) bool {
+		if configs[i].Priority != configs[j].Priority {
+			return configs[i].Priority > configs[j].Priority
+		}
+		return configs[i].CallbackID < configs[j].CallbackID
+	})
+	return configs
+}
+
+// FindMatched returns the config to be fired by the message, the first of FindAllMatched.
+func (q *ConfigMap) FindMatched(channel, text string, inThread bool) *Config {
+	configs := q.FindAllMatched(channel, text, inThread)
+	if len(configs) == 0 {
+		return nil
+	}
+	return configs[0]
+}
+
+// FindAllMatched returns the configs to be fired by the message in order of priority, by their match modes.
+// inThread is true if the message is a reply in a thread.
+func (q *ConfigMap) FindAllMatched(channel, text string, inThread bool) []*Config {
+	matched := []*Config{}
+	for _, config := range q.Sorted() {
+		// Slash command, reaction, schedule and webhook only config are not triggered by message.
+		if config.Command != "" || config.Reaction != "" || config.ScheduleString != "" || config.RegexpString == "" {
+			continue
+		}
+		if !config.MatchThread(inThread) {
+			continue
+		}
+		for _, ch := range config.Channels {
+			if ch == channel && config.Regexp.MatchString(text) {
+				matched = append(matched, config)
+				break
+			}
+		}
+	}
+
+	fired := []*Config{}
+	for _, config := range matched {
+		switch config.MatchMode {
+		case MatchModeExclusive:
+			if len(matched) == 1 {
+				fired = append(fired, config)
+			}
+		case MatchModeAll:
+			fired = append(fired, config)
+		default:
+			return append(fired, config)
+		}
+	}
+	return fired
+}
+
+// FindByCommand returns the config bound to the slash command in the channel.
+func (q *ConfigMap) FindByCommand(command, channel string) *Config {
+	for _, config := range q.Sorted() {
+		if config.Command != command {
+			continue
+		}
+		for _, ch := range config.Channels {
+			if ch == channel {
+				return config
+			}
+		}
+	}
+	return nil
+}
+
+// HasReaction returns true if any config is triggered by the reaction.
+func (q *ConfigMap) HasReaction(reaction string) bool {
 	for _, config := range *q {
+		if config.Reaction == reaction {
+			return true
+		}
+	}
+	return false
+}
+
+// FindByReaction returns the config triggered by the reaction to the message in the channel.
+func (q *ConfigMap) FindByReaction(reaction, channel, text string) *Config {
+	for _, config := range q.Sorted() {
+		if config.Reaction != reaction {
+			continue
+		}
 		for _, ch := range config.Channels {
 			if ch == channel && config.Regexp.MatchString(text) {
 				return config
@@ -41,68 +174,228 @@ func (q *ConfigMap) FindByCallbackID(callbackID string) *Config {
 }
 
 type Config struct {
-	Title              string   // for admin
-	Channels           []string `validate:"unique,required,dive,required"`
-	TextTemplateString string   `validate:"required"`
-	RegexpString       string   `validate:"required"`
-	Actions            []string `validate:"unique"`
-	CallbackID         string   // should be unique
-	Confirm            bool
-	URLTemplateString  string `validate:"required"`
-	BodyTemplateString string
-	Type               string
-	Secrets            map[string]string
-
-	Regexp       *regexp.Regexp
-	URLTemplate  *template.Template
-	BodyTemplate *template.Template
-	TextTemplate *template.Template
+	Title                    string   // for admin
+	Channels                 []string `validate:"unique,required,dive,required"`
+	TextTemplateString       string   `validate:"required"`
+	RegexpString             string   // required unless schedule or webhook
+	Actions                  []string `validate:"unique"`
+	CallbackID               string   // should be unique
+	Confirm                  bool
+	URLTemplateString        string `validate:"required"`
+	BodyTemplateString       string
+	Type                     string
+	Secrets                  map[string]string
+	Method                   string `validate:"omitempty,oneof=GET POST PUT PATCH DELETE"`
+	ContentType              string
+	Headers                  map[string]string // value is template
+	ResponseTemplateString   string
+	RetryMaxAttempts         int `validate:"min=0,max=10"`
+	RetryBackoffString       string
+	RetryStatusCodes         []int `validate:"unique,dive,min=100,max=599"`
+	TimeoutString            string
+	Team                     string   // owner team, editors of the team can change
+	Command                  string   `validate:"omitempty,startswith=/,excludes= "` // slash command, instead of message
+	Reaction                 string   `validate:"excludesall=: "`                    // emoji name, instead of message
+	ScheduleString           string   // cron expression, instead of message
+	Steps                    []Step   `validate:"unique=Name,dive"` // Actions is a single step if empty
+	OptionsURLTemplateString string   // JSON list of Option, overrides Actions
+	Approvers                []string `validate:"unique,dive,required"` // user or user group IDs, anyone if empty
+	RequiredApprovals        int      `validate:"min=0,max=10"`         // distinct approvers, 1 if 0
+	DenySelfApproval         bool     // requester can't approve own request
+	AllowedUsers             []string `validate:"unique,dive,required"` // user or user group IDs, anyone if empty
+	DeniedUsers              []string `validate:"unique,dive,required"` // user or user group IDs, prior to AllowedUsers
+	Priority                 int      // higher is matched first
+	MatchMode                string   `validate:"omitempty,oneof=first all exclusive"`
+	ReplyInThread            bool     // reply in the thread of the triggering message
+	ThreadMatch              string   `validate:"omitempty,oneof=thread top"`
+	StatusURLTemplateString  string   // polled after the request succeeded, with its response
+	StatusIntervalString     string
+	StatusTimeoutString      string
+	StatusSuccessString      string // template, the job succeeded if true
+	StatusFailureString      string // template, the job failed if true
+	StatusPendingString      string // template, the job is running if true
+
+	Regexp             *regexp.Regexp
+	URLTemplate        *template.Template
+	BodyTemplate       *template.Template
+	TextTemplate       *template.Template
+	HeaderTemplates    map[string]*template.Template
+	ResponseTemplate   *template.Template
+	OptionsURLTemplate *template.Template
+	StatusURLTemplate  *template.Template
+	StatusTemplates    map[string]*template.Template // by job state
+	RetryBackoff       time.Duration
+	Timeout            time.Duration
+	StatusInterval     time.Duration
+	StatusTimeout      time.Duration
+	Schedule           cron.Schedule
+}
+
+const (
+	// DefaultStepName is the name of the step made from Actions.
+	DefaultStepName = "value"
+	DefaultStepText = "Select your choice"
+)
+
+// Step is one select of interactive workflow, chosen value is .values.<Name> in templates.
+type Step struct {
+	Name    string   `validate:"required,excludesall= ."`
+	Text    string   // DefaultStepText if empty
+	Options []string `validate:"required,unique,dive,required"`
+}
+
+// Option is the choice of select, returned by OptionsURL.
+type Option struct {
+	Label string `json:"label"` // Value if empty
+	Value string `json:"value"`
+}
+
+// Response is the result of the outgoing request, passed to ResponseTemplate.
+type Response struct {
+	StatusCode int
+	Header     map[string][]string
+	Body       []byte
 }
 
 func ConfigValidator(sl validator.StructLevel) {
 	config := sl.Current().Interface().(Config)
 
-	_, err := template.New("body").Parse(config.BodyTemplateString)
+	_, err := newTemplate("body").Parse(config.BodyTemplateString)
 	if err != nil {
 		sl.ReportError(config.BodyTemplateString, "BodyTemplateString", "", "", "")
 	}
 
-	_, err = template.New("url").Parse(config.URLTemplateString)
+	_, err = newTemplate("url").Parse(config.URLTemplateString)
 	if err != nil {
 		sl.ReportError(config.URLTemplateString, "URLTemplateString", "", "", "")
 	}
 
-	_, err = template.New("text").Parse(config.TextTemplateString)
+	_, err = newTemplate("text").Parse(config.TextTemplateString)
 	if err != nil {
 		sl.ReportError(config.TextTemplateString, "TextTemplateString", "", "", "")
 	}
 
-	_, err = regexp.Compile(config.RegexpString)
+	re, err := regexp.Compile(config.RegexpString)
 	if err != nil {
 		sl.ReportError(config.RegexpString, "RegexpString", "", "", "")
+	} else {
+		validateGroups(sl, re, config)
+	}
+	if config.RegexpString == "" && config.ScheduleString == "" && !config.HasWebhookAuth() {
+		sl.ReportError(config.RegexpString, "RegexpString", "", "required", "")
+	}
+
+	_, err = newTemplate("response").Parse(config.ResponseTemplateString)
+	if err != nil {
+		sl.ReportError(config.ResponseTemplateString, "ResponseTemplateString", "", "", "")
+	}
+
+	_, err = newTemplate("options").Parse(config.OptionsURLTemplateString)
+	if err != nil {
+		sl.ReportError(config.OptionsURLTemplateString, "OptionsURLTemplateString", "", "", "")
+	}
+
+	if config.RetryBackoffString != "" {
+		d, err := time.ParseDuration(config.RetryBackoffString)
+		if err != nil || d < 0 {
+			sl.ReportError(config.RetryBackoffString, "RetryBackoffString", "", "", "")
+		}
+	}
+
+	if config.TimeoutString != "" {
+		d, err := time.ParseDuration(config.TimeoutString)
+		if err != nil || d <= 0 {
+			sl.ReportError(config.TimeoutString, "TimeoutString", "", "", "")
+		}
+	}
+
+	validateStatus(sl, config)
+
+	// Approvals are asked after the selection, a request without it is sent at once.
+	if config.IsConfirmRequired() && !config.IsInteractive() {
+		sl.ReportError(config.Confirm, "Confirm", "", "interactive", "")
+	}
+
+	if config.ScheduleString != "" {
+		_, err = cron.ParseStandard(config.ScheduleString)
+		if err != nil {
+			sl.ReportError(config.ScheduleString, "ScheduleString", "", "", "")
+		}
+	}
+
+	for k, v := range config.Headers {
+		if !headerKeyRegexp.MatchString(k) {
+			sl.ReportError(config.Headers, "Headers", "", "", "")
+			continue
+		}
+		_, err = newTemplate("header").Parse(v)
+		if err != nil {
+			sl.ReportError(config.Headers, "Headers", "", "", "")
+		}
 	}
 }
 
 func (c *Config) ExecSecretValueMask(raw string) string {
 	result := raw
 	for _, v := range c.Secrets {
+		if v == "" || v == SercretValueMask {
+			continue
+		}
 		result = strings.Replace(result, v, SercretValueMask, -1)
 	}
 	return result
 }
 
-func (c *Config) TextCompile(matched []string) (string, error) {
+// templateData returns the data passed to the templates, secrets are only for the request.
+func (c *Config) templateData(sess *SessionValue, withSecrets bool) map[string]interface{} {
+	data := map[string]interface{}{
+		"value":   sess.Value,
+		"matched": sess.Matched,
+		"payload": sess.Payload,
+		"values":  sess.Values,
+		"groups":  c.namedGroups(sess.Matched),
+		"user": map[string]string{
+			"id":   sess.Context.UserID,
+			"name": sess.Context.UserName,
+		},
+		"channel": map[string]string{
+			"id":   sess.Context.ChannelID,
+			"name": sess.Context.ChannelName,
+		},
+		"ts":        sess.Context.Timestamp,
+		"thread_ts": sess.Context.ThreadTS,
+		"permalink": sess.Context.Permalink,
+		"time":      sess.Context.Time,
+	}
+	if withSecrets {
+		data["secrets"] = c.Secrets
+	}
+	return data
+}
+
+// namedGroups returns the named capture groups of Regexp in the matched.
+func (c *Config) namedGroups(matched []string) map[string]string {
+	groups := make(map[string]string)
+	for i, name := range c.Regexp.SubexpNames() {
+		if name != "" && i < len(matched) {
+			groups[name] = matched[i]
+		}
+	}
+	return groups
+}
+
+func (c *Config) TextCompile(sess *SessionValue) (string, error) {
 	textBuf := new(bytes.Buffer)
-	err := c.TextTemplate.Execute(textBuf, map[string]interface{}{"matched": matched})
+	err := c.TextTemplate.Execute(textBuf, c.templateData(sess, false))
 	if err != nil {
 		return "", errors.Wrap(err, "Text template failed")
 	}
 	return textBuf.String(), nil
 }
 
-func (c *Config) URLCompile(value string, matched []string, secrets map[string]string) (string, error) {
+func (c *Config) URLCompile(sess *SessionValue) (string, error) {
 	urlBuf := new(bytes.Buffer)
-	err := c.URLTemplate.Execute(urlBuf, map[string]interface{}{"value": value, "matched": matched, "secrets": secrets})
+	err := c.URLTemplate.Execute(urlBuf, c.templateData(sess, true))
 	if err != nil {
 		return "", errors.Wrap(err, "URL template failed")
 	}
@@ -114,15 +407,270 @@ func (c *Config) URLCompile(value string, matched []string, secrets map[string]s
 	return parsedURL.String(), nil
 }
 
-func (c *Config) BodyCompile(value string, matched []string, secrets map[string]string) (string, error) {
+// OptionsURLCompile renders the URL to load the options of Actions.
+func (c *Config) OptionsURLCompile(sess *SessionValue) (string, error) {
+	urlBuf := new(bytes.Buffer)
+	err := c.OptionsURLTemplate.Execute(urlBuf, c.templateData(sess, true))
+	if err != nil {
+		return "", errors.Wrap(err, "Options URL template failed")
+	}
+
+	parsedURL, err := url.Parse(urlBuf.String())
+	if err != nil {
+		return "", errors.Wrap(err, "Options URL parse failed")
+	}
+	return parsedURL.String(), nil
+}
+
+func (c *Config) BodyCompile(sess *SessionValue) (string, error) {
 	bodyBuf := new(bytes.Buffer)
-	err := c.BodyTemplate.Execute(bodyBuf, map[string]interface{}{"value": value, "matched": matched, "secrets": secrets})
+	err := c.BodyTemplate.Execute(bodyBuf, c.templateData(sess, true))
 	if err != nil {
 		return "", errors.Wrap(err, "Body template failed")
 	}
 	return bodyBuf.String(), nil
 }
 
+func (c *Config) HeaderCompile(sess *SessionValue) (map[string]string, error) {
+	headers := make(map[string]string)
+	for k, t := range c.HeaderTemplates {
+		headerBuf := new(bytes.Buffer)
+		err := t.Execute(headerBuf, c.templateData(sess, true))
+		if err != nil {
+			return nil, errors.Wrapf(err, "Header template failed: %s", k)
+		}
+		headers[k] = headerBuf.String()
+	}
+	return headers, nil
+}
+
+// HeaderCompileFor renders the headers to send to the target URL other than the request URL.
+// They are sent only to the same scheme and host as the request URL, not to leak secrets in them
+// to the host from the response.
+func (c *Config) HeaderCompileFor(sess *SessionValue, target string) (map[string]string, error) {
+	requestURL, err := c.URLCompile(sess)
+	if err != nil {
+		return nil, err
+	}
+	if !sameOrigin(requestURL, target) {
+		return map[string]string{}, nil
+	}
+	return c.HeaderCompile(sess)
+}
+
+// sameOrigin returns true if the URLs have the same scheme and host, including port.
+func sameOrigin(a, b string) bool {
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return ua.Host != "" && strings.EqualFold(ua.Scheme, ub.Scheme) && strings.EqualFold(ua.Host, ub.Host)
+}
+
+// requestCompileError returns the first error of rendering the request.
+func (c *Config) requestCompileError(sess *SessionValue) error {
+	if _, err := c.URLCompile(sess); err != nil {
+		return err
+	}
+	if _, err := c.BodyCompile(sess); err != nil {
+		return err
+	}
+	_, err := c.HeaderCompile(sess)
+	return err
+}
+
+// ResponseCompile renders the reply from the response of the request.
+// body is parsed JSON if possible, otherwise raw text.
+func (c *Config) ResponseCompile(sess *SessionValue, resp *Response) (string, error) {
+	if c.ResponseTemplateString == "" {
+		return "", nil
+	}
+
+	responseBuf := new(bytes.Buffer)
+	err := c.ResponseTemplate.Execute(responseBuf, c.responseData(sess, resp, false))
+	if err != nil {
+		return "", errors.Wrap(err, "Response template failed")
+	}
+	return responseBuf.String(), nil
+}
+
+// responseData returns the data passed to the templates with the response.
+func (c *Config) responseData(sess *SessionValue, resp *Response, withSecrets bool) map[string]interface{} {
+	var body interface{}
+	if err := json.Unmarshal(resp.Body, &body); err != nil {
+		body = string(resp.Body)
+	}
+
+	data := c.templateData(sess, withSecrets)
+	data["status"] = resp.StatusCode
+	data["headers"] = resp.Header
+	data["body"] = body
+	data["raw"] = string(resp.Body)
+	return data
+}
+
+// InteractiveSteps returns the steps to be selected, empty for non interactive config.
+func (c *Config) InteractiveSteps() []Step {
+	if len(c.Steps) > 0 {
+		return c.Steps
+	}
+	if len(c.Actions) > 0 || c.OptionsURLTemplateString != "" {
+		return []Step{{Name: DefaultStepName, Options: c.Actions}}
+	}
+	return nil
+}
+
+func (c *Config) IsInteractive() bool {
+	return len(c.InteractiveSteps()) > 0
+}
+
+// GroupMembership tells whether the user is a member of the user group.
+type GroupMembership func(group, user string) (bool, error)
+
+// IsUserAllowed returns true if the user can trigger the config.
+func (c *Config) IsUserAllowed(user string, membership GroupMembership) (bool, error) {
+	denied, err := isListed(c.DeniedUsers, user, membership)
+	if err != nil || denied {
+		return false, err
+	}
+	if len(c.AllowedUsers) == 0 {
+		return true, nil
+	}
+	return isListed(c.AllowedUsers, user, membership)
+}
+
+// IsApprover returns true if the user can approve the request.
+func (c *Config) IsApprover(user string, membership GroupMembership) (bool, error) {
+	if len(c.Approvers) == 0 {
+		return true, nil
+	}
+	return isListed(c.Approvers, user, membership)
+}
+
+// isListed returns true if the user is in the list directly or by user group.
+func isListed(list []string, user string, membership GroupMembership) (bool, error) {
+	for _, id := range list {
+		if id == user {
+			return true, nil
+		}
+	}
+	for _, id := range list {
+		if !IsUserGroupID(id) {
+			continue
+		}
+		member, err := membership(id, user)
+		if err != nil || member {
+			return member, err
+		}
+	}
+	return false, nil
+}
+
+// IsUserGroupID returns true for Slack user group ID, e.g. S0123ABCD.
+func IsUserGroupID(ID string) bool {
+	return strings.HasPrefix(ID, "S")
+}
+
+// MatchThread returns true if the message is triggerable by ThreadMatch.
+func (c *Config) MatchThread(inThread bool) bool {
+	switch c.ThreadMatch {
+	case ThreadMatchThread:
+		return inThread
+	case ThreadMatchTop:
+		return !inThread
+	}
+	return true
+}
+
+// ReplyThreadTS returns thread_ts to reply to the triggering message, empty to reply in channel.
+func (c *Config) ReplyThreadTS(context MessageContext) string {
+	if !c.ReplyInThread {
+		return ""
+	}
+	if context.ThreadTS != "" {
+		return context.ThreadTS
+	}
+	return context.Timestamp
+}
+
+// IsConfirmRequired returns true if the request waits for approval before sending.
+func (c *Config) IsConfirmRequired() bool {
+	return c.Confirm || len(c.Approvers) > 0 || c.RequiredApprovals > 0
+}
+
+// ApprovalQuorum returns the number of distinct approvers to send the request.
+func (c *Config) ApprovalQuorum() int {
+	if c.RequiredApprovals < 1 {
+		return 1
+	}
+	return c.RequiredApprovals
+}
+
+// Approve adds the approval of the user to the session,
+// the user should be checked by Approvers before.
+func (c *Config) Approve(sess *SessionValue, user string) error {
+	if c.IsApproved(sess) {
+		return errors.New("Request is already approved")
+	}
+	// Who selected any step is a part of the request, not only the requester.
+	if c.DenySelfApproval && sess.IsSelector(user) {
+		return errors.New("Requester can't approve own request")
+	}
+	for _, approval := range sess.Approvals {
+		if approval == user {
+			return errors.New("Already approved by the user")
+		}
+	}
+	sess.Approvals = append(sess.Approvals, user)
+	return nil
+}
+
+// IsApproved returns true if the quorum is met.
+func (c *Config) IsApproved(sess *SessionValue) bool {
+	return len(sess.Approvals) >= c.ApprovalQuorum()
+}
+
+// HasWebhookAuth returns true if inbound webhook is enabled.
+func (c *Config) HasWebhookAuth() bool {
+	return c.Secrets[WebhookTokenSecretKey] != "" || c.Secrets[WebhookHMACSecretKey] != ""
+}
+
+// VerifyWebhook checks the token or HMAC signature of the webhook request.
+func (c *Config) VerifyWebhook(authorization, signature string, body []byte) bool {
+	if token := c.Secrets[WebhookTokenSecretKey]; token != "" {
+		if subtle.ConstantTimeCompare([]byte(authorization), []byte("Bearer "+token)) == 1 {
+			return true
+		}
+	}
+	if secret := c.Secrets[WebhookHMACSecretKey]; secret != "" {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		if hmac.Equal([]byte(expected), []byte(signature)) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRetryStatus returns true if the request should be retried on the status code.
+func (c *Config) IsRetryStatus(statusCode int) bool {
+	codes := c.RetryStatusCodes
+	if len(codes) == 0 {
+		codes = DefaultRetryStatusCodes
+	}
+	for _, code := range codes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Config) Hydrate() {
 	// Assign callback ID, new config
 	if c.CallbackID == "" {
@@ -136,12 +684,36 @@ func (c *Config) Hydrate() {
 
 	// Compile from string
 	c.BodyTemplate =
-		template.Must(template.New(c.CallbackID + "body").Parse(c.BodyTemplateString))
+		template.Must(newTemplate(c.CallbackID + "body").Parse(c.BodyTemplateString))
 	c.URLTemplate =
-		template.Must(template.New(c.CallbackID + "url").Parse(c.URLTemplateString))
+		template.Must(newTemplate(c.CallbackID + "url").Parse(c.URLTemplateString))
 	c.TextTemplate =
-		template.Must(template.New(c.CallbackID + "text").Parse(c.TextTemplateString))
+		template.Must(newTemplate(c.CallbackID + "text").Parse(c.TextTemplateString))
+	c.ResponseTemplate =
+		template.Must(newTemplate(c.CallbackID + "response").Parse(c.ResponseTemplateString))
+	c.OptionsURLTemplate =
+		template.Must(newTemplate(c.CallbackID + "options").Parse(c.OptionsURLTemplateString))
 	c.Regexp = regexp.MustCompile(c.RegexpString)
+	c.RetryBackoff = DefaultRetryBackoff
+	if d, err := time.ParseDuration(c.RetryBackoffString); err == nil {
+		c.RetryBackoff = d
+	}
+	c.Timeout = DefaultTimeout
+	if d, err := time.ParseDuration(c.TimeoutString); err == nil {
+		c.Timeout = d
+	}
+	c.hydrateStatus()
+	c.Schedule = nil
+	if c.ScheduleString != "" {
+		if schedule, err := cron.ParseStandard(c.ScheduleString); err == nil {
+			c.Schedule = schedule
+		}
+	}
+	c.HeaderTemplates = make(map[string]*template.Template)
+	for k, v := range c.Headers {
+		c.HeaderTemplates[k] =
+			template.Must(newTemplate(c.CallbackID + "header" + k).Parse(v))
+	}
 }
 
 func (c *Config) Mask() {
@@ -149,3 +721,32 @@ func (c *Config) Mask() {
 		c.Secrets[k] = SercretValueMask
 	}
 }
+
+// WithSecretPlaceholders returns the copy of the config whose secrets are the mask,
+// it renders the templates without secrets, even if they are encoded like urlquery or b64enc.
+func (c *Config) WithSecretPlaceholders() *Config {
+	masked := *c
+	masked.Secrets = make(map[string]string, len(c.Secrets))
+	for k := range c.Secrets {
+		masked.Secrets[k] = SercretValueMask
+	}
+	return &masked
+}
+
+// HasSameSecretTemplates returns true if the templates rendered with secrets are not changed.
+// URL is included, the headers are sent to its host.
+func (c *Config) HasSameSecretTemplates(other *Config) bool {
+	if c.URLTemplateString != other.URLTemplateString ||
+		c.BodyTemplateString != other.BodyTemplateString ||
+		c.OptionsURLTemplateString != other.OptionsURLTemplateString ||
+		c.StatusURLTemplateString != other.StatusURLTemplateString ||
+		len(c.Headers) != len(other.Headers) {
+		return false
+	}
+	for k, v := range c.Headers {
+		if o, ok := other.Headers[k]; !ok || o != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,446 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newMatchConfig(ID, regexp string, priority int, mode string) *Config {
+	c := &Config{
+		CallbackID:         ID,
+		Channels:           []string{"general"},
+		TextTemplateString: ID,
+		RegexpString:       regexp,
+		URLTemplateString:  "http://localhost",
+		Priority:           priority,
+		MatchMode:          mode,
+	}
+	c.Hydrate()
+	return c
+}
+
+func newThreadConfig(ID, threadMatch string) *Config {
+	c := newMatchConfig(ID, "^deploy", 0, MatchModeAll)
+	c.ThreadMatch = threadMatch
+	return c
+}
+
+func TestConfigMap_FindAllMatched(t *testing.T) {
+	type args struct {
+		channel  string
+		text     string
+		inThread bool
+	}
+	tests := []struct {
+		name    string
+		configs []*Config
+		args    args
+		want    []string
+	}{
+		{
+			name: "same priority by callback ID",
+			configs: []*Config{
+				newMatchConfig("b", "^deploy api", 0, ""),
+				newMatchConfig("a", "^deploy", 0, ""),
+			},
+			args: args{"general", "deploy api", false},
+			want: []string{"a"},
+		},
+		{
+			name: "higher priority first",
+			configs: []*Config{
+				newMatchConfig("a", "^deploy", 0, MatchModeFirst),
+				newMatchConfig("b", "^deploy api", 10, MatchModeFirst),
+			},
+			args: args{"general", "deploy api", false},
+			want: []string{"b"},
+		},
+		{
+			name: "all until first",
+			configs: []*Config{
+				newMatchConfig("a", "^deploy", 10, MatchModeAll),
+				newMatchConfig("b", "api", 5, MatchModeFirst),
+				newMatchConfig("c", "^deploy api$", 0, MatchModeFirst),
+			},
+			args: args{"general", "deploy api", false},
+			want: []string{"a", "b"},
+		},
+		{
+			name: "exclusive with others",
+			configs: []*Config{
+				newMatchConfig("a", "^deploy", 10, MatchModeExclusive),
+				newMatchConfig("b", "api", 0, MatchModeAll),
+			},
+			args: args{"general", "deploy api", false},
+			want: []string{"b"},
+		},
+		{
+			name: "exclusive alone",
+			configs: []*Config{
+				newMatchConfig("a", "^deploy", 10, MatchModeExclusive),
+				newMatchConfig("b", "api", 0, MatchModeAll),
+			},
+			args: args{"general", "deploy web", false},
+			want: []string{"a"},
+		},
+		{
+			name: "other channel",
+			configs: []*Config{
+				newMatchConfig("a", "^deploy", 0, ""),
+			},
+			args: args{"random", "deploy api", false},
+			want: []string{},
+		},
+		{
+			name: "thread reply",
+			configs: []*Config{
+				newThreadConfig("a", ThreadMatchTop),
+				newThreadConfig("b", ThreadMatchThread),
+				newThreadConfig("c", ThreadMatchAny),
+			},
+			args: args{"general", "deploy api", true},
+			want: []string{"b", "c"},
+		},
+		{
+			name: "top-level message",
+			configs: []*Config{
+				newThreadConfig("a", ThreadMatchTop),
+				newThreadConfig("b", ThreadMatchThread),
+				newThreadConfig("c", ThreadMatchAny),
+			},
+			args: args{"general", "deploy api", false},
+			want: []string{"a", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := ConfigMap{}
+			for _, c := range tt.configs {
+				q[c.CallbackID] = c
+			}
+			got := []string{}
+			for _, c := range q.FindAllMatched(tt.args.channel, tt.args.text, tt.args.inThread) {
+				got = append(got, c.CallbackID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConfigMap.FindAllMatched() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigMap_FindMatched(t *testing.T) {
+	q := ConfigMap{}
+	for _, c := range []*Config{
+		newMatchConfig("a", "^deploy", 0, ""),
+		newMatchConfig("b", "^deploy api", 1, ""),
+		newMatchConfig("c", "^deploy api", 1, ""),
+	} {
+		q[c.CallbackID] = c
+	}
+	// Map iteration order is random, the result should not be.
+	for i := 0; i < 20; i++ {
+		if got := q.FindMatched("general", "deploy api", false); got == nil || got.CallbackID != "b" {
+			t.Fatalf("ConfigMap.FindMatched() = %v, want b", got)
+		}
+	}
+	if got := q.FindMatched("general", "release", false); got != nil {
+		t.Errorf("ConfigMap.FindMatched() = %v, want nil", got.CallbackID)
+	}
+}
+
+func TestConfig_TextCompile(t *testing.T) {
+	c := &Config{
+		CallbackID:         "deploy",
+		RegexpString:       `^deploy (?P<app>\S+) to (?P<env>\S+)$`,
+		TextTemplateString: `{{.groups.app}} {{index .groups "env"}} by @{{.user.name}} in #{{.channel.name}} {{.permalink}}`,
+	}
+	c.Hydrate()
+	sess := &SessionValue{
+		Matched: c.Regexp.FindStringSubmatch("deploy api to staging"),
+		Context: MessageContext{
+			UserID:      "U1",
+			UserName:    "alice",
+			ChannelID:   "C1",
+			ChannelName: "general",
+			Permalink:   "https://example.slack.com/archives/C1/p1",
+		},
+	}
+	got, err := c.TextCompile(sess)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "api staging by @alice in #general https://example.slack.com/archives/C1/p1"
+	if got != want {
+		t.Errorf("Config.TextCompile() = %q, want %q", got, want)
+	}
+}
+
+func TestConfig_ReplyThreadTS(t *testing.T) {
+	tests := []struct {
+		name          string
+		replyInThread bool
+		context       MessageContext
+		want          string
+	}{
+		{"in channel", false, MessageContext{Timestamp: "2.0", ThreadTS: "1.0"}, ""},
+		{"top-level message", true, MessageContext{Timestamp: "2.0"}, "2.0"},
+		{"thread reply", true, MessageContext{Timestamp: "2.0", ThreadTS: "1.0"}, "1.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{ReplyInThread: tt.replyInThread}
+			if got := c.ReplyThreadTS(tt.context); got != tt.want {
+				t.Errorf("Config.ReplyThreadTS() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigValidator_groups(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		url       string
+		headers   map[string]string
+		statusURL string
+		success   string
+		wantErr   bool
+	}{
+		{
+			name: "existing groups",
+			text: `{{.groups.app}}`,
+			url:  `http://localhost/{{index .groups "env"}}`,
+		},
+		{
+			name:    "unknown field",
+			text:    `{{if .groups.app}}{{.groups.branch}}{{end}}`,
+			url:     "http://localhost",
+			wantErr: true,
+		},
+		{
+			name:    "unknown index",
+			text:    "deploy",
+			url:     `http://localhost/{{index .groups "branch"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown in header",
+			text:    "deploy",
+			url:     "http://localhost",
+			headers: map[string]string{"X-Branch": "{{.groups.branch}}"},
+			wantErr: true,
+		},
+		{
+			name:      "existing in status",
+			text:      "deploy",
+			url:       "http://localhost",
+			statusURL: "http://localhost/{{.groups.app}}/{{.body.id}}",
+			success:   `{{eq .body.env .groups.env}}`,
+		},
+		{
+			name:      "unknown in status URL",
+			text:      "deploy",
+			url:       "http://localhost",
+			statusURL: "http://localhost/{{.groups.branch}}/{{.body.id}}",
+			success:   "{{.body.done}}",
+			wantErr:   true,
+		},
+		{
+			name:      "unknown in status",
+			text:      "deploy",
+			url:       "http://localhost",
+			statusURL: "http://localhost/{{.body.id}}",
+			success:   `{{eq .body.branch .groups.branch}}`,
+			wantErr:   true,
+		},
+	}
+	v := NewValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{
+				Channels:                []string{"general"},
+				RegexpString:            `^deploy (?P<app>\S+) to (?P<env>\S+)$`,
+				TextTemplateString:      tt.text,
+				URLTemplateString:       tt.url,
+				Headers:                 tt.headers,
+				StatusURLTemplateString: tt.statusURL,
+				StatusSuccessString:     tt.success,
+			}
+			if err := v.ValidateConfig(c); (err != nil) != tt.wantErr {
+				t.Errorf("Validator.ValidateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfig_HeaderCompile(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "secret",
+			headers: map[string]string{"Authorization": "Bearer {{.secrets.TOKEN}}", "X-App": "{{index .matched 1}}"},
+			want:    map[string]string{"Authorization": "Bearer secret", "X-App": "api"},
+		},
+		{
+			name:    "template error",
+			headers: map[string]string{"X-App": "{{index .matched 5}}"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{
+				CallbackID:        "deploy",
+				RegexpString:      `^deploy (\S+)$`,
+				URLTemplateString: "http://localhost",
+				Secrets:           map[string]string{"TOKEN": "secret"},
+				Headers:           tt.headers,
+			}
+			c.Hydrate()
+			got, err := c.HeaderCompile(&SessionValue{Matched: c.Regexp.FindStringSubmatch("deploy api")})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Config.HeaderCompile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Config.HeaderCompile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_HeaderCompileFor(t *testing.T) {
+	c := &Config{
+		CallbackID:        "deploy",
+		URLTemplateString: "https://ci.example.com/deploy",
+		Secrets:           map[string]string{"TOKEN": "secret"},
+		Headers:           map[string]string{"Authorization": "Bearer {{.secrets.TOKEN}}"},
+	}
+	c.Hydrate()
+	tests := []struct {
+		name   string
+		target string
+		want   map[string]string
+	}{
+		{"same host", "https://ci.example.com/jobs/42", map[string]string{"Authorization": "Bearer secret"}},
+		{"other host", "https://evil.example.com/jobs/42", map[string]string{}},
+		{"other port", "https://ci.example.com:8443/jobs/42", map[string]string{}},
+		{"other scheme", "http://ci.example.com/jobs/42", map[string]string{}},
+		{"invalid", "://", map[string]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.HeaderCompileFor(&SessionValue{}, tt.target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Config.HeaderCompileFor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_ResponseCompile(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		body     string
+		want     string
+		wantErr  bool
+	}{
+		{name: "empty", body: `{"id":42}`},
+		{name: "json", template: `{{.status}} job {{.body.id}} for {{index .matched 1}}`, body: `{"id":42}`, want: "201 job 42 for api"},
+		{name: "text", template: `{{.body}}`, body: "queued", want: "queued"},
+		{name: "raw", template: `{{.raw}}`, body: `{"id":42}`, want: `{"id":42}`},
+		{name: "template error", template: `{{index .body.ids 3}}`, body: `{"ids":[]}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{
+				CallbackID:             "deploy",
+				RegexpString:           `^deploy (\S+)$`,
+				URLTemplateString:      "http://localhost",
+				ResponseTemplateString: tt.template,
+			}
+			c.Hydrate()
+			sess := &SessionValue{Matched: c.Regexp.FindStringSubmatch("deploy api")}
+			got, err := c.ResponseCompile(sess, &Response{StatusCode: 201, Body: []byte(tt.body)})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Config.ResponseCompile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Config.ResponseCompile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigValidator_method(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		wantErr bool
+	}{
+		{name: "default"},
+		{name: "GET", method: "GET"},
+		{name: "DELETE", method: "DELETE"},
+		{name: "unsupported", method: "HEAD", wantErr: true},
+		{name: "lower case", method: "get", wantErr: true},
+	}
+	v := NewValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{
+				Channels:           []string{"general"},
+				RegexpString:       "^deploy$",
+				TextTemplateString: "deploy",
+				URLTemplateString:  "http://localhost",
+				Method:             tt.method,
+			}
+			if err := v.ValidateConfig(c); (err != nil) != tt.wantErr {
+				t.Errorf("Validator.ValidateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigValidator_approval(t *testing.T) {
+	tests := []struct {
+		name              string
+		actions           []string
+		confirm           bool
+		approvers         []string
+		requiredApprovals int
+		wantErr           bool
+	}{
+		{name: "no approval"},
+		{name: "confirm with actions", actions: []string{"master"}, confirm: true},
+		{name: "approvers with actions", actions: []string{"master"}, approvers: []string{"U1"}, requiredApprovals: 2},
+		{name: "confirm without actions", confirm: true, wantErr: true},
+		{name: "approvers without actions", approvers: []string{"U1"}, wantErr: true},
+		{name: "approvals without actions", requiredApprovals: 2, wantErr: true},
+	}
+	v := NewValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{
+				Channels:           []string{"general"},
+				RegexpString:       "^deploy$",
+				TextTemplateString: "deploy",
+				URLTemplateString:  "http://localhost",
+				Actions:            tt.actions,
+				Confirm:            tt.confirm,
+				Approvers:          tt.approvers,
+				RequiredApprovals:  tt.requiredApprovals,
+			}
+			if err := v.ValidateConfig(c); (err != nil) != tt.wantErr {
+				t.Errorf("Validator.ValidateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
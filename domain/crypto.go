@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+const saltSize = 16
+
+func secretCipher(password string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(password), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, errors.Wrap(err, "Key derivation failed")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cipher initialize failed")
+	}
+	return cipher.NewGCM(block)
+}
+
+// EncryptSecret encrypts secret value with the password, for dump.
+func EncryptSecret(password, value string) (string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return "", errors.Wrap(err, "Salt generation failed")
+	}
+	aead, err := secretCipher(password, salt)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", errors.Wrap(err, "Nonce generation failed")
+	}
+
+	// salt | nonce | ciphertext
+	sealed := append(salt, nonce...)
+	sealed = aead.Seal(sealed, nonce, []byte(value), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptSecret decrypts secret value encrypted by EncryptSecret.
+func DecryptSecret(password, encrypted string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", errors.Wrap(err, "Secret is not base64")
+	}
+	if len(sealed) < saltSize {
+		return "", errors.New("Secret is too short")
+	}
+	aead, err := secretCipher(password, sealed[:saltSize])
+	if err != nil {
+		return "", err
+	}
+	sealed = sealed[saltSize:]
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("Secret is too short")
+	}
+	value, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", errors.New("Wrong password or broken secret")
+	}
+	return string(value), nil
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+)
+
+// Execution is a record of one outgoing request, secrets are masked.
+type Execution struct {
+	ID         string
+	Time       time.Time
+	SessionID  string
+	ConfigID   string
+	User       string
+	Channel    string
+	Method     string
+	URL        string
+	Body       string
+	StatusCode int // 0 if no response
+	Latency    time.Duration
+	Error      string
+}
+
+type ExecutionFilter struct {
+	ConfigID string
+}
+
+func (f *ExecutionFilter) Match(execution *Execution) bool {
+	return f.ConfigID == "" || f.ConfigID == execution.ConfigID
+}
+
+type ExecutionRepository interface {
+	AddExecution(*Execution) error
+	GetExecution(ID string) (*Execution, error)
+	// ListExecutions returns executions newest first, skips offset and returns at most limit.
+	ListExecutions(filter *ExecutionFilter, offset, limit int) ([]*Execution, error)
+}
+
+func NewExecution(c *Config, sess *SessionValue, user, channel string) *Execution {
+	return &Execution{
+		ID:        xid.New().String(),
+		Time:      time.Now(),
+		SessionID: sess.ID,
+		ConfigID:  c.CallbackID,
+		User:      user,
+		Channel:   channel,
+	}
+}
+
+// SetResult records the request rendered with placeholder secrets and the error.
+// Masking the sent request is not enough, templates may encode secrets like urlquery.
+func (e *Execution) SetResult(c *Config, sess *SessionValue, err error) {
+	masked := c.WithSecretPlaceholders()
+	url, _ := masked.URLCompile(sess)
+	body, _ := masked.BodyCompile(sess)
+	if err != nil {
+		message := err.Error()
+		if e.URL == "" {
+			// Rendering failed, its error may have the rendered secrets.
+			if renderErr := masked.requestCompileError(sess); renderErr != nil {
+				message = renderErr.Error()
+			}
+		} else {
+			// Errors like url.Error have the sent URL.
+			message = strings.Replace(message, e.URL, url, -1)
+			if e.Body != "" {
+				message = strings.Replace(message, e.Body, body, -1)
+			}
+		}
+		e.Error = c.ExecSecretValueMask(message)
+	}
+	e.URL = url
+	e.Body = body
+}
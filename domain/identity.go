@@ -0,0 +1,81 @@
+package domain
+
+import "context"
+
+const (
+	// RoleViewer can read configs, without secrets.
+	RoleViewer = "viewer"
+	// RoleEditor can also change configs of own teams.
+	RoleEditor = "editor"
+	// RoleOwner can do everything, delete configs and see secrets.
+	RoleOwner = "owner"
+)
+
+var roleLevel = map[string]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleOwner:  3,
+}
+
+// IsValidRole returns true if role is known.
+func IsValidRole(role string) bool {
+	_, ok := roleLevel[role]
+	return ok
+}
+
+// Identity is the authenticated admin user.
+type Identity struct {
+	Name  string
+	Role  string
+	Teams []string
+}
+
+// AnonymousIdentity is used if admin authentication is disabled.
+var AnonymousIdentity = &Identity{
+	Name: "anonymous",
+	Role: RoleOwner,
+}
+
+func (i *Identity) hasRole(role string) bool {
+	return roleLevel[i.Role] >= roleLevel[role]
+}
+
+func (i *Identity) CanView() bool {
+	return i.hasRole(RoleViewer)
+}
+
+// CanEdit returns true if the identity can change the config.
+// Editors can change only configs tagged with their team.
+func (i *Identity) CanEdit(config *Config) bool {
+	if i.hasRole(RoleOwner) {
+		return true
+	}
+	if !i.hasRole(RoleEditor) || config.Team == "" {
+		return false
+	}
+	for _, team := range i.Teams {
+		if team == config.Team {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *Identity) CanDelete() bool {
+	return i.hasRole(RoleOwner)
+}
+
+func (i *Identity) CanSeeSecrets() bool {
+	return i.hasRole(RoleOwner)
+}
+
+type identityKey struct{}
+
+func NewContextWithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok && identity != nil
+}
@@ -0,0 +1,108 @@
+package domain
+
+import "time"
+
+type SessionValue struct {
+	Matched   []string
+	Value     string
+	ID        string
+	Payload   interface{}       // JSON payload of webhook
+	Values    map[string]string // chosen value of each step
+	Step      int               // index of the step to be selected
+	Options   []string          // values offered by OptionsURL in the current step
+	Requester string            // user ID who selected the first value
+	Selectors []string          // user IDs who selected any value, including the requester
+	Approvals []string          // user IDs who approved the request
+	Context   MessageContext    // where and by whom the request is triggered
+}
+
+// MessageContext is the message which triggered the request, available in templates.
+type MessageContext struct {
+	UserID      string
+	UserName    string
+	ChannelID   string
+	ChannelName string
+	Timestamp   string // ts of the message
+	ThreadTS    string // ts of the parent message, if in thread
+	Permalink   string
+	Time        time.Time
+}
+
+// Copy returns the copy of the session, stores keep their own copy not to share it with callers.
+// Payload is shared, it's never modified.
+func (s *SessionValue) Copy() *SessionValue {
+	c := *s
+	c.Matched = copyStrings(s.Matched)
+	c.Options = copyStrings(s.Options)
+	c.Approvals = copyStrings(s.Approvals)
+	c.Selectors = copyStrings(s.Selectors)
+	if s.Values != nil {
+		c.Values = make(map[string]string, len(s.Values))
+		for k, v := range s.Values {
+			c.Values[k] = v
+		}
+	}
+	return &c
+}
+
+func copyStrings(list []string) []string {
+	if list == nil {
+		return nil
+	}
+	return append([]string{}, list...)
+}
+
+// Select sets the chosen value of the step, and goes to the next step.
+func (s *SessionValue) Select(step Step, value string) {
+	if s.Values == nil {
+		s.Values = make(map[string]string)
+	}
+	s.Value = value
+	s.Values[step.Name] = value
+	s.Step++
+	s.Options = nil
+}
+
+// SelectedBy records the user who selected the value, the first one is the requester.
+func (s *SessionValue) SelectedBy(user string) {
+	if s.Requester == "" {
+		s.Requester = user
+	}
+	if !s.IsSelector(user) {
+		s.Selectors = append(s.Selectors, user)
+	}
+}
+
+// IsSelector returns true if the user selected any value of the request.
+func (s *SessionValue) IsSelector(user string) bool {
+	if s.Requester != "" && user == s.Requester {
+		return true
+	}
+	for _, v := range s.Selectors {
+		if v == user {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOffered returns true if the value can be selected in the step.
+func (s *SessionValue) IsOffered(step Step, value string) bool {
+	for _, v := range step.Options {
+		if v == value {
+			return true
+		}
+	}
+	for _, v := range s.Options {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type SessionStore interface {
+	Get(ID string) (*SessionValue, bool)
+	Set(ID string, sess *SessionValue, expire time.Duration) error
+	DeleteExpired() error
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"bytes"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// Job states, decided by the status templates with the status response.
+const (
+	JobPending = "pending"
+	JobSuccess = "success"
+	JobFailure = "failure"
+)
+
+const (
+	DefaultStatusInterval = 10 * time.Second
+	DefaultStatusTimeout  = 30 * time.Minute
+	MinStatusInterval     = 1 * time.Second
+)
+
+// statusOrder is the order to check the status templates,
+// pending first because the job usually has no result while running.
+var statusOrder = []string{JobPending, JobSuccess, JobFailure}
+
+// IsStatusPolling returns true if the job started by the request is tracked by StatusURL.
+func (c *Config) IsStatusPolling() bool {
+	return c.StatusURLTemplateString != ""
+}
+
+// statusStrings returns the status templates by job state.
+func (c *Config) statusStrings() map[string]string {
+	return map[string]string{
+		JobPending: c.StatusPendingString,
+		JobSuccess: c.StatusSuccessString,
+		JobFailure: c.StatusFailureString,
+	}
+}
+
+// StatusURLCompile renders the URL to poll from the response of the request.
+func (c *Config) StatusURLCompile(sess *SessionValue, resp *Response) (string, error) {
+	urlBuf := new(bytes.Buffer)
+	err := c.StatusURLTemplate.Execute(urlBuf, c.responseData(sess, resp, true))
+	if err != nil {
+		return "", errors.Wrap(err, "Status URL template failed")
+	}
+
+	parsedURL, err := url.Parse(strings.TrimSpace(urlBuf.String()))
+	if err != nil {
+		return "", errors.Wrap(err, "Status URL parse failed")
+	}
+	return parsedURL.String(), nil
+}
+
+// JobState returns the state of the job from the status response, by the first true template.
+// The job is pending until success or failure if StatusPendingString is empty,
+// otherwise it's an error that none of them is true.
+func (c *Config) JobState(sess *SessionValue, resp *Response) (string, error) {
+	data := c.responseData(sess, resp, false)
+	for _, state := range statusOrder {
+		t, ok := c.StatusTemplates[state]
+		if !ok {
+			continue
+		}
+		buf := new(bytes.Buffer)
+		if err := t.Execute(buf, data); err != nil {
+			return "", errors.Wrapf(err, "Status %s template failed", state)
+		}
+		if isTrue(buf.String()) {
+			return state, nil
+		}
+	}
+	if c.StatusPendingString == "" {
+		return JobPending, nil
+	}
+	return "", errors.New("Unknown job status")
+}
+
+// isTrue returns true if the rendered template is true, e.g. "true", "True" or "1".
+func isTrue(rendered string) bool {
+	b, err := strconv.ParseBool(strings.TrimSpace(rendered))
+	return err == nil && b
+}
+
+func (c *Config) hydrateStatus() {
+	c.StatusURLTemplate =
+		template.Must(newTemplate(c.CallbackID + "status").Parse(c.StatusURLTemplateString))
+	c.StatusTemplates = make(map[string]*template.Template)
+	for state, v := range c.statusStrings() {
+		if v == "" {
+			continue
+		}
+		c.StatusTemplates[state] =
+			template.Must(newTemplate(c.CallbackID + "status" + state).Parse(v))
+	}
+	c.StatusInterval = DefaultStatusInterval
+	if d, err := time.ParseDuration(c.StatusIntervalString); err == nil {
+		c.StatusInterval = d
+	}
+	c.StatusTimeout = DefaultStatusTimeout
+	if d, err := time.ParseDuration(c.StatusTimeoutString); err == nil {
+		c.StatusTimeout = d
+	}
+}
+
+func validateStatus(sl validator.StructLevel, config Config) {
+	_, err := newTemplate("status").Parse(config.StatusURLTemplateString)
+	if err != nil {
+		sl.ReportError(config.StatusURLTemplateString, "StatusURLTemplateString", "", "", "")
+	}
+
+	for state, v := range config.statusStrings() {
+		_, err = newTemplate("status" + state).Parse(v)
+		if err != nil {
+			sl.ReportError(v, "Status"+strings.Title(state)+"String", "", "", "")
+		}
+	}
+
+	// The job never ends without success or failure.
+	if config.StatusURLTemplateString != "" && config.StatusSuccessString == "" && config.StatusFailureString == "" {
+		sl.ReportError(config.StatusSuccessString, "StatusSuccessString", "", "required", "")
+	}
+
+	if config.StatusIntervalString != "" {
+		d, err := time.ParseDuration(config.StatusIntervalString)
+		if err != nil || d < MinStatusInterval {
+			sl.ReportError(config.StatusIntervalString, "StatusIntervalString", "", "", "")
+		}
+	}
+
+	if config.StatusTimeoutString != "" {
+		d, err := time.ParseDuration(config.StatusTimeoutString)
+		if err != nil || d <= 0 {
+			sl.ReportError(config.StatusTimeoutString, "StatusTimeoutString", "", "", "")
+		}
+	}
+}
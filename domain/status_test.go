@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestConfig_JobState(t *testing.T) {
+	tests := []struct {
+		name    string
+		pending string
+		success string
+		failure string
+		body    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "pending",
+			pending: `{{.body.building}}`,
+			success: `{{eq .body.result "SUCCESS"}}`,
+			failure: `{{ne .body.result "SUCCESS"}}`,
+			body:    `{"building":true,"result":null}`,
+			want:    JobPending,
+		},
+		{
+			name:    "success",
+			pending: `{{.body.building}}`,
+			success: `{{eq .body.result "SUCCESS"}}`,
+			failure: `{{ne .body.result "SUCCESS"}}`,
+			body:    `{"building":false,"result":"SUCCESS"}`,
+			want:    JobSuccess,
+		},
+		{
+			name:    "failure",
+			pending: `{{.body.building}}`,
+			success: `{{eq .body.result "SUCCESS"}}`,
+			failure: `{{ne .body.result "SUCCESS"}}`,
+			body:    `{"building":false,"result":"FAILURE"}`,
+			want:    JobFailure,
+		},
+		{
+			name:    "pending without template",
+			success: `{{eq .body.state "success"}}`,
+			body:    `{"state":"queued"}`,
+			want:    JobPending,
+		},
+		{
+			name:    "unknown",
+			pending: `{{eq .body.state "running"}}`,
+			success: `{{eq .body.state "success"}}`,
+			body:    `{"state":"queued"}`,
+			wantErr: true,
+		},
+		{
+			name:    "template error",
+			success: `{{index .body.states 3}}`,
+			body:    `{"states":[]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{
+				CallbackID:              "deploy",
+				StatusURLTemplateString: "http://localhost",
+				StatusPendingString:     tt.pending,
+				StatusSuccessString:     tt.success,
+				StatusFailureString:     tt.failure,
+			}
+			c.Hydrate()
+			got, err := c.JobState(&SessionValue{}, &Response{StatusCode: 200, Body: []byte(tt.body)})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Config.JobState() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Config.JobState() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_StatusURLCompile(t *testing.T) {
+	c := &Config{
+		CallbackID:              "deploy",
+		Secrets:                 map[string]string{"TOKEN": "secret"},
+		StatusURLTemplateString: `http://localhost/jobs/{{.body.id}}?token={{.secrets.TOKEN}}`,
+	}
+	c.Hydrate()
+	got, err := c.StatusURLCompile(&SessionValue{}, &Response{StatusCode: 201, Body: []byte(`{"id":42}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "http://localhost/jobs/42?token=secret"; got != want {
+		t.Errorf("Config.StatusURLCompile() = %q, want %q", got, want)
+	}
+}
+
+func TestConfigValidator_status(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		success  string
+		failure  string
+		interval string
+		timeout  string
+		wantErr  bool
+	}{
+		{name: "no polling"},
+		{name: "success", url: "http://localhost/{{.body.id}}", success: "{{.body.done}}", interval: "5s", timeout: "1h"},
+		{name: "failure only", url: "http://localhost/{{.body.id}}", failure: "{{.body.failed}}"},
+		{name: "never ends", url: "http://localhost/{{.body.id}}", wantErr: true},
+		{name: "invalid template", url: "http://localhost/{{.body.id}}", success: "{{.body.done", wantErr: true},
+		{name: "too short interval", url: "http://localhost", success: "true", interval: "10ms", wantErr: true},
+		{name: "invalid timeout", url: "http://localhost", success: "true", timeout: "soon", wantErr: true},
+	}
+	v := NewValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{
+				Channels:                []string{"general"},
+				RegexpString:            "^deploy$",
+				TextTemplateString:      "deploy",
+				URLTemplateString:       "http://localhost",
+				StatusURLTemplateString: tt.url,
+				StatusSuccessString:     tt.success,
+				StatusFailureString:     tt.failure,
+				StatusIntervalString:    tt.interval,
+				StatusTimeoutString:     tt.timeout,
+			}
+			if err := v.ValidateConfig(c); (err != nil) != tt.wantErr {
+				t.Errorf("Validator.ValidateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
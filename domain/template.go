@@ -0,0 +1,238 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// EnvPrefix limits the environment variables readable by env function in templates.
+const EnvPrefix = "FIRESTARTER_"
+
+// templateFuncs are available in all templates, in addition to the builtins like urlquery.
+var templateFuncs = template.FuncMap{
+	"json":       toJSON,
+	"toJson":     toJSON,
+	"pathescape": url.PathEscape,
+	"upper":      strings.ToUpper,
+	"lower":      strings.ToLower,
+	"trim":       strings.TrimSpace,
+	"default":    defaultValue,
+	"split":      split,
+	"join":       join,
+	"now":        time.Now,
+	"date":       date,
+	"env":        env,
+	"b64enc":     b64enc,
+	"b64dec":     b64dec,
+	"sha256sum":  sha256sum,
+}
+
+func newTemplate(name string) *template.Template {
+	return template.New(name).Funcs(templateFuncs)
+}
+
+// toJSON quotes the value as JSON, e.g. {"branch": {{json .value}}}.
+func toJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", errors.Wrap(err, "json failed")
+	}
+	return string(b), nil
+}
+
+// defaultValue returns def if the value is empty, e.g. {{.value | default "master"}}.
+func defaultValue(def, value interface{}) interface{} {
+	if isEmpty(value) {
+		return def
+	}
+	return value
+}
+
+func isEmpty(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+	return reflect.DeepEqual(value, reflect.Zero(v.Type()).Interface())
+}
+
+// split splits the string by sep, e.g. {{.value | split ","}}.
+func split(sep, s string) []string {
+	return strings.Split(s, sep)
+}
+
+// join joins the list by sep, e.g. {{join "," .matched}}.
+func join(sep string, list interface{}) (string, error) {
+	v := reflect.ValueOf(list)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return "", errors.Errorf("join: %T is not a list", list)
+	}
+	items := make([]string, v.Len())
+	for i := range items {
+		items[i] = fmt.Sprint(v.Index(i).Interface())
+	}
+	return strings.Join(items, sep), nil
+}
+
+// date formats the time by Go layout, e.g. {{date "2006-01-02" .time}}.
+// t is time.Time, unix seconds, or RFC3339 string as in JSON payload.
+func date(layout string, t interface{}) (string, error) {
+	switch v := t.(type) {
+	case time.Time:
+		return v.Format(layout), nil
+	case *time.Time:
+		return v.Format(layout), nil
+	case int:
+		return time.Unix(int64(v), 0).Format(layout), nil
+	case int64:
+		return time.Unix(v, 0).Format(layout), nil
+	case float64:
+		return time.Unix(int64(v), 0).Format(layout), nil
+	case string:
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return "", errors.Wrap(err, "date failed")
+		}
+		return parsed.Format(layout), nil
+	}
+	return "", errors.Errorf("date: %T is not a time", t)
+}
+
+// env returns the environment variable, only EnvPrefix ones not to leak credentials of the server.
+func env(name string) string {
+	if !strings.HasPrefix(name, EnvPrefix) {
+		return ""
+	}
+	return os.Getenv(name)
+}
+
+func b64enc(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+func b64dec(s string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", errors.Wrap(err, "b64dec failed")
+	}
+	return string(b), nil
+}
+
+// sha256sum returns hex digest of the string.
+func sha256sum(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// validateGroups reports the templates referring named groups which are not in the regexp.
+func validateGroups(sl validator.StructLevel, re *regexp.Regexp, config Config) {
+	names := make(map[string]bool)
+	for _, name := range re.SubexpNames() {
+		names[name] = true
+	}
+
+	templates := map[string]string{
+		"TextTemplateString":       config.TextTemplateString,
+		"URLTemplateString":        config.URLTemplateString,
+		"BodyTemplateString":       config.BodyTemplateString,
+		"ResponseTemplateString":   config.ResponseTemplateString,
+		"OptionsURLTemplateString": config.OptionsURLTemplateString,
+		"StatusURLTemplateString":  config.StatusURLTemplateString,
+		"StatusPendingString":      config.StatusPendingString,
+		"StatusSuccessString":      config.StatusSuccessString,
+		"StatusFailureString":      config.StatusFailureString,
+	}
+	for k, v := range config.Headers {
+		templates["Headers."+k] = v
+	}
+	for field, text := range templates {
+		for _, name := range referredGroups(text) {
+			if !names[name] {
+				sl.ReportError(text, field, "", "group", name)
+			}
+		}
+	}
+}
+
+// referredGroups returns the names of .groups.<name> and (index .groups "<name>") in the template.
+func referredGroups(text string) []string {
+	t, err := newTemplate("groups").Parse(text)
+	if err != nil || t.Tree == nil {
+		return nil
+	}
+	names := []string{}
+	var walk func(node parse.Node)
+	walk = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.IfNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.TemplateNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, cmd := range n.Cmds {
+				walk(cmd)
+			}
+		case *parse.CommandNode:
+			if len(n.Args) >= 3 {
+				fn, isIdent := n.Args[0].(*parse.IdentifierNode)
+				field, isField := n.Args[1].(*parse.FieldNode)
+				name, isString := n.Args[2].(*parse.StringNode)
+				if isIdent && isField && isString && fn.Ident == "index" &&
+					len(field.Ident) == 1 && field.Ident[0] == "groups" {
+					names = append(names, name.Text)
+				}
+			}
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		case *parse.FieldNode:
+			if len(n.Ident) >= 2 && n.Ident[0] == "groups" {
+				names = append(names, n.Ident[1])
+			}
+		}
+	}
+	walk(t.Tree.Root)
+	return names
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	os.Setenv("FIRESTARTER_TEST_HOST", "example.com")
+	os.Setenv("TEST_SECRET", "secret")
+	defer os.Unsetenv("FIRESTARTER_TEST_HOST")
+	defer os.Unsetenv("TEST_SECRET")
+
+	data := map[string]interface{}{
+		"value":   `say "hi"`,
+		"empty":   "",
+		"list":    []string{"a", "b"},
+		"payload": map[string]interface{}{"tags": []interface{}{"x", 1.0}, "at": 1.5e9, "iso": "2018-01-02T03:04:05Z"},
+		"time":    time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErr  bool
+	}{
+		{name: "json string", template: `{"v": {{json .value}}}`, want: `{"v": "say \"hi\""}`},
+		{name: "toJson list", template: `{{toJson .list}}`, want: `["a","b"]`},
+		{name: "json map", template: `{{json .payload.tags}}`, want: `["x",1]`},
+		{name: "urlquery", template: `{{urlquery .value}}`, want: `say+%22hi%22`},
+		{name: "pathescape", template: `{{pathescape "a b/c"}}`, want: `a%20b%2Fc`},
+		{name: "upper", template: `{{upper "abc"}}`, want: `ABC`},
+		{name: "lower", template: `{{"ABC" | lower}}`, want: `abc`},
+		{name: "trim", template: `{{trim "  abc \n"}}`, want: `abc`},
+		{name: "default empty", template: `{{.empty | default "master"}}`, want: `master`},
+		{name: "default missing", template: `{{default "master" .missing}}`, want: `master`},
+		{name: "default set", template: `{{.value | default "master"}}`, want: `say "hi"`},
+		{name: "split", template: `{{range split "," "a,b,c"}}[{{.}}]{{end}}`, want: `[a][b][c]`},
+		{name: "split pipe", template: `{{index ("a-b" | split "-") 1}}`, want: `b`},
+		{name: "join strings", template: `{{join "," .list}}`, want: `a,b`},
+		{name: "join interfaces", template: `{{join " " .payload.tags}}`, want: `x 1`},
+		{name: "join not list", template: `{{join "," .value}}`, wantErr: true},
+		{name: "date time", template: `{{date "2006-01-02 15:04" .time}}`, want: `2018-01-02 03:04`},
+		{name: "date unix", template: `{{date "2006-01-02" .payload.at}}`, want: time.Unix(1.5e9, 0).Format("2006-01-02")},
+		{name: "date RFC3339", template: `{{date "Jan 2" .payload.iso}}`, want: `Jan 2`},
+		{name: "date invalid", template: `{{date "2006" "yesterday"}}`, wantErr: true},
+		{name: "now", template: `{{if (now).IsZero}}zero{{else}}now{{end}}`, want: `now`},
+		{name: "env", template: `{{env "FIRESTARTER_TEST_HOST"}}`, want: `example.com`},
+		{name: "env without prefix", template: `{{env "TEST_SECRET"}}`, want: ``},
+		{name: "b64enc", template: `{{b64enc "user:pass"}}`, want: `dXNlcjpwYXNz`},
+		{name: "b64dec", template: `{{b64dec "dXNlcjpwYXNz"}}`, want: `user:pass`},
+		{name: "b64dec invalid", template: `{{b64dec "%%%"}}`, wantErr: true},
+		{name: "sha256sum", template: `{{sha256sum "abc"}}`, want: `ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := newTemplate(tt.name).Parse(tt.template)
+			if err != nil {
+				t.Fatal(err)
+			}
+			buf := new(bytes.Buffer)
+			err = tmpl.Execute(buf, data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && buf.String() != tt.want {
+				t.Errorf("Execute() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigValidator_templateFuncs(t *testing.T) {
+	v := NewValidator()
+	c := &Config{
+		Channels:           []string{"general"},
+		RegexpString:       "^deploy (.+)$",
+		TextTemplateString: `{{index .matched 1 | upper}}`,
+		URLTemplateString:  `http://localhost/{{index .matched 1 | pathescape}}`,
+		BodyTemplateString: `{"branch": {{index .matched 1 | json}}}`,
+	}
+	if err := v.ValidateConfig(c); err != nil {
+		t.Errorf("Validator.ValidateConfig() error = %v", err)
+	}
+	c.BodyTemplateString = `{{unknown .value}}`
+	if err := v.ValidateConfig(c); err == nil {
+		t.Errorf("Validator.ValidateConfig() unknown function is valid")
+	}
+}
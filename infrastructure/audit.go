@@ -0,0 +1,80 @@
+package infrastructure
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/juntaki/firestarter/domain"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// AuditRepositoryFileImpl appends audit events to JSON lines file, never rewrites it.
+type AuditRepositoryFileImpl struct {
+	mutex     *sync.Mutex
+	auditFile string
+	logger    *zap.SugaredLogger
+}
+
+func NewAuditRepositoryFileImpl(logger *zap.SugaredLogger, auditFile string) *AuditRepositoryFileImpl {
+	return &AuditRepositoryFileImpl{
+		mutex:     &sync.Mutex{},
+		auditFile: auditFile,
+		logger:    logger,
+	}
+}
+
+func (a *AuditRepositoryFileImpl) AddAuditEvent(event *domain.AuditEvent) error {
+	bytes, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "JSON marshal failed")
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	f, err := os.OpenFile(a.auditFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "Failed to open audit file")
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(bytes, '\n')); err != nil {
+		return errors.Wrap(err, "Failed to write audit event")
+	}
+	return nil
+}
+
+func (a *AuditRepositoryFileImpl) ListAuditEvents(filter *domain.AuditFilter) ([]*domain.AuditEvent, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	events := []*domain.AuditEvent{}
+	f, err := os.Open(a.auditFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return events, nil
+		}
+		return nil, errors.Wrap(err, "Failed to open audit file")
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		event := &domain.AuditEvent{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			a.logger.Errorw("Broken audit event", zap.Error(err))
+			continue
+		}
+		if filter.Match(event) {
+			events = append(events, event)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "Failed to read audit file")
+	}
+	return events, nil
+}
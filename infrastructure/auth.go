@@ -0,0 +1,358 @@
+package infrastructure
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	oidc "github.com/coreos/go-oidc"
+	"github.com/juntaki/firestarter/domain"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/oauth2"
+)
+
+// parseRoleTeams parses optional "role:team1+team2" part of credential entry.
+// Role is viewer if omitted.
+func parseRoleTeams(name string, fields []string) (*domain.Identity, error) {
+	identity := &domain.Identity{
+		Name: name,
+		Role: domain.RoleViewer,
+	}
+	if len(fields) > 0 && fields[0] != "" {
+		if !domain.IsValidRole(fields[0]) {
+			return nil, errors.Errorf("Invalid role for %s: %s", name, fields[0])
+		}
+		identity.Role = fields[0]
+	}
+	if len(fields) > 1 && fields[1] != "" {
+		identity.Teams = strings.Split(fields[1], "+")
+	}
+	return identity, nil
+}
+
+// TokenAuthenticatorImpl authenticates "Authorization: Bearer <token>" by static API tokens.
+type TokenAuthenticatorImpl struct {
+	tokens map[string]*domain.Identity
+}
+
+// NewTokenAuthenticatorImpl parses tokens like "name1:token1:owner,name2:token2:editor:team1+team2".
+func NewTokenAuthenticatorImpl(spec string) (*TokenAuthenticatorImpl, error) {
+	a := &TokenAuthenticatorImpl{
+		tokens: make(map[string]*domain.Identity),
+	}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		fields := strings.SplitN(entry, ":", 4)
+		if len(fields) < 2 || fields[0] == "" || fields[1] == "" {
+			return nil, errors.Errorf("Invalid token entry: %s", fields[0])
+		}
+		identity, err := parseRoleTeams(fields[0], fields[2:])
+		if err != nil {
+			return nil, err
+		}
+		a.tokens[fields[1]] = identity
+	}
+	return a, nil
+}
+
+func (a *TokenAuthenticatorImpl) Authenticate(r *http.Request) (*domain.Identity, error) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return nil, nil
+	}
+	token := strings.TrimPrefix(auth, "Bearer ")
+	for t, identity := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return identity, nil
+		}
+	}
+	return nil, errors.New("Invalid token")
+}
+
+// BasicAuthenticatorImpl authenticates HTTP basic auth by user file.
+// Each line of the file is "user:bcrypt-hash[:role[:team1+team2]]", like htpasswd -B.
+type BasicAuthenticatorImpl struct {
+	users map[string]*basicUser
+}
+
+type basicUser struct {
+	hash     []byte
+	identity *domain.Identity
+}
+
+func NewBasicAuthenticatorImpl(userFile string) (*BasicAuthenticatorImpl, error) {
+	f, err := os.Open(userFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to open user file")
+	}
+	defer f.Close()
+
+	a := &BasicAuthenticatorImpl{
+		users: make(map[string]*basicUser),
+	}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.SplitN(line, ":", 4)
+		if len(fields) < 2 {
+			return nil, errors.Errorf("Invalid user file line: %s", fields[0])
+		}
+		identity, err := parseRoleTeams(fields[0], fields[2:])
+		if err != nil {
+			return nil, err
+		}
+		a.users[fields[0]] = &basicUser{
+			hash:     []byte(fields[1]),
+			identity: identity,
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "Failed to read user file")
+	}
+	return a, nil
+}
+
+func (a *BasicAuthenticatorImpl) Authenticate(r *http.Request) (*domain.Identity, error) {
+	user, password, ok := r.BasicAuth()
+	if !ok {
+		return nil, nil
+	}
+	u, ok := a.users[user]
+	if !ok {
+		return nil, errors.Errorf("Unknown user: %s", user)
+	}
+	if err := bcrypt.CompareHashAndPassword(u.hash, []byte(password)); err != nil {
+		return nil, errors.Errorf("Wrong password: %s", user)
+	}
+	return u.identity, nil
+}
+
+const (
+	oidcSessionCookie = "firestarter_session"
+	oidcStateCookie   = "firestarter_state"
+	oidcSessionExpire = 12 * time.Hour
+)
+
+type oidcSession struct {
+	Name   string
+	Role   string
+	Teams  []string
+	Expire time.Time
+}
+
+// OIDCAuthenticatorImpl authenticates by signed session cookie, issued after OIDC login flow.
+type OIDCAuthenticatorImpl struct {
+	oauth2Config *oauth2.Config
+	verifier     *oidc.IDTokenVerifier
+	cookieSecret []byte
+	secureCookie bool // the admin is served by https
+	logger       *zap.SugaredLogger
+}
+
+func NewOIDCAuthenticatorImpl(
+	logger *zap.SugaredLogger,
+	issuer, clientID, clientSecret, redirectURL string,
+	cookieSecret []byte,
+) (*OIDCAuthenticatorImpl, error) {
+	provider, err := oidc.NewProvider(context.Background(), issuer)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get OIDC provider")
+	}
+
+	if len(cookieSecret) == 0 {
+		// Login session is lost on restart.
+		logger.Warn("Cookie secret is not set, generate random one")
+		cookieSecret = make([]byte, 32)
+		if _, err := rand.Read(cookieSecret); err != nil {
+			return nil, errors.Wrap(err, "Failed to generate cookie secret")
+		}
+	}
+
+	return &OIDCAuthenticatorImpl{
+		oauth2Config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Endpoint:     provider.Endpoint(),
+			RedirectURL:  redirectURL,
+			Scopes:       []string{oidc.ScopeOpenID, "profile", "email"},
+		},
+		verifier:     provider.Verifier(&oidc.Config{ClientID: clientID}),
+		cookieSecret: cookieSecret,
+		secureCookie: strings.HasPrefix(strings.ToLower(redirectURL), "https://"),
+		logger:       logger,
+	}, nil
+}
+
+func (a *OIDCAuthenticatorImpl) Authenticate(r *http.Request) (*domain.Identity, error) {
+	cookie, err := r.Cookie(oidcSessionCookie)
+	if err != nil {
+		return nil, nil
+	}
+	payload, err := a.verifyCookie(cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	var session oidcSession
+	if err := json.Unmarshal(payload, &session); err != nil {
+		return nil, errors.Wrap(err, "Invalid session")
+	}
+	if time.Now().After(session.Expire) {
+		// Login again
+		return nil, nil
+	}
+	return &domain.Identity{
+		Name:  session.Name,
+		Role:  session.Role,
+		Teams: session.Teams,
+	}, nil
+}
+
+// LoginHandler redirects to OIDC provider.
+func (a *OIDCAuthenticatorImpl) LoginHandler(w http.ResponseWriter, r *http.Request) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		a.logger.Errorw("Failed to generate state", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	state := base64.RawURLEncoding.EncodeToString(buf)
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state,
+		Path:     "/",
+		MaxAge:   600,
+		HttpOnly: true,
+		Secure:   a.secureCookie,
+	})
+	http.Redirect(w, r, a.oauth2Config.AuthCodeURL(state), http.StatusFound)
+}
+
+// CallbackHandler receives authorization code from OIDC provider, and issues session cookie.
+func (a *OIDCAuthenticatorImpl) CallbackHandler(w http.ResponseWriter, r *http.Request) {
+	state, err := r.Cookie(oidcStateCookie)
+	if err != nil || state.Value == "" || state.Value != r.URL.Query().Get("state") {
+		a.logger.Error("Invalid OIDC state")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	token, err := a.oauth2Config.Exchange(r.Context(), r.URL.Query().Get("code"))
+	if err != nil {
+		a.logger.Errorw("Failed to exchange token", zap.Error(err))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		a.logger.Error("No id_token in token response")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	idToken, err := a.verifier.Verify(r.Context(), rawIDToken)
+	if err != nil {
+		a.logger.Errorw("Failed to verify ID token", zap.Error(err))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	// Role is the highest one in "roles" claim, and teams are "groups" claim.
+	var claims struct {
+		Email  string   `json:"email"`
+		Roles  []string `json:"roles"`
+		Groups []string `json:"groups"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		a.logger.Errorw("Failed to parse claims", zap.Error(err))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	name := claims.Email
+	if name == "" {
+		name = idToken.Subject
+	}
+
+	role := domain.RoleViewer
+	for _, r := range claims.Roles {
+		switch {
+		case r == domain.RoleOwner:
+			role = r
+		case r == domain.RoleEditor && role != domain.RoleOwner:
+			role = r
+		}
+	}
+
+	payload, err := json.Marshal(&oidcSession{
+		Name:   name,
+		Role:   role,
+		Teams:  claims.Groups,
+		Expire: time.Now().Add(oidcSessionExpire),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcSessionCookie,
+		Value:    a.signCookie(payload),
+		Path:     "/",
+		MaxAge:   int(oidcSessionExpire.Seconds()),
+		HttpOnly: true,
+		Secure:   a.secureCookie,
+	})
+	http.SetCookie(w, &http.Cookie{
+		Name:   oidcStateCookie,
+		Path:   "/",
+		MaxAge: -1,
+		Secure: a.secureCookie,
+	})
+	a.logger.Infow("OIDC login", zap.String("name", name))
+	http.Redirect(w, r, "/", http.StatusFound)
+}
+
+func (a *OIDCAuthenticatorImpl) signCookie(payload []byte) string {
+	mac := hmac.New(sha256.New, a.cookieSecret)
+	mac.Write(payload)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (a *OIDCAuthenticatorImpl) verifyCookie(value string) ([]byte, error) {
+	parts := strings.SplitN(value, ".", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("Malformed session cookie")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "Malformed session cookie")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.Wrap(err, "Malformed session cookie")
+	}
+
+	mac := hmac.New(sha256.New, a.cookieSecret)
+	mac.Write(payload)
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return nil, errors.New("Invalid session cookie signature")
+	}
+	return payload, nil
+}
@@ -0,0 +1,296 @@
+package infrastructure
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/juntaki/firestarter/domain"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+func TestTokenAuthenticatorImpl_Authenticate(t *testing.T) {
+	a, err := NewTokenAuthenticatorImpl("ci:secret-token, alice:alice-token:editor:team1+team2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          *domain.Identity
+		wantErr       bool
+	}{
+		{
+			name:          "valid",
+			authorization: "Bearer alice-token",
+			want:          &domain.Identity{Name: "alice", Role: "editor", Teams: []string{"team1", "team2"}},
+		},
+		{
+			name:          "default role",
+			authorization: "Bearer secret-token",
+			want:          &domain.Identity{Name: "ci", Role: "viewer"},
+		},
+		{
+			name:          "invalid",
+			authorization: "Bearer wrong",
+			wantErr:       true,
+		},
+		{
+			name: "no credential",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/twirp/firestarter.ConfigService/GetConfigList", nil)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+			got, err := a.Authenticate(r)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TokenAuthenticatorImpl.Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TokenAuthenticatorImpl.Authenticate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBasicAuthenticatorImpl_Authenticate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userFile := filepath.Join(dir, "users")
+	if err := ioutil.WriteFile(userFile, []byte("# comment\nalice:"+string(hash)+":owner\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	a, err := NewBasicAuthenticatorImpl(userFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		user     string
+		password string
+		want     *domain.Identity
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			user:     "alice",
+			password: "password",
+			want:     &domain.Identity{Name: "alice", Role: "owner"},
+		},
+		{
+			name:     "wrong password",
+			user:     "alice",
+			password: "wrong",
+			wantErr:  true,
+		},
+		{
+			name:     "unknown user",
+			user:     "bob",
+			password: "password",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.SetBasicAuth(tt.user, tt.password)
+			got, err := a.Authenticate(r)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BasicAuthenticatorImpl.Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BasicAuthenticatorImpl.Authenticate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newOIDCStandIn starts minimal OIDC provider, which issues ID token for email.
+func newOIDCStandIn(t *testing.T, clientID, email string) *httptest.Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: &jose.JSONWebKey{Key: key, KeyID: "test"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                server.URL,
+			"authorization_endpoint":                server.URL + "/auth",
+			"token_endpoint":                        server.URL + "/token",
+			"jwks_uri":                              server.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "test", Algorithm: "RS256", Use: "sig"}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		claims, _ := json.Marshal(map[string]interface{}{
+			"iss":    server.URL,
+			"sub":    "1234",
+			"aud":    clientID,
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"iat":    time.Now().Unix(),
+			"email":  email,
+			"roles":  []string{"viewer", "editor"},
+			"groups": []string{"infra"},
+		})
+		signed, err := signer.Sign(claims)
+		if err != nil {
+			t.Fatal(err)
+		}
+		idToken, _ := signed.CompactSerialize()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+	return server
+}
+
+func TestOIDCAuthenticatorImpl_LoginFlow(t *testing.T) {
+	zapLogger, err := zap.NewProduction()
+	if err != nil {
+		panic("logger initialize failed")
+	}
+	logger := zapLogger.Sugar()
+
+	provider := newOIDCStandIn(t, "firestarter", "alice@example.com")
+	defer provider.Close()
+
+	a, err := NewOIDCAuthenticatorImpl(logger, provider.URL,
+		"firestarter", "client-secret", "http://localhost:8080/auth/callback", []byte("cookie-secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Login redirects to provider with state
+	login := httptest.NewRecorder()
+	a.LoginHandler(login, httptest.NewRequest("GET", "/auth/login", nil))
+	location, err := url.Parse(login.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := location.Query().Get("state")
+	if state == "" {
+		t.Fatal("LoginHandler() no state in redirect")
+	}
+
+	// Provider redirects back with code
+	callbackReq := httptest.NewRequest("GET", "/auth/callback?code=code&state="+state, nil)
+	for _, c := range login.Result().Cookies() {
+		callbackReq.AddCookie(c)
+	}
+	callback := httptest.NewRecorder()
+	a.CallbackHandler(callback, callbackReq)
+	if callback.Code != http.StatusFound {
+		t.Fatalf("CallbackHandler() status = %d", callback.Code)
+	}
+
+	// Session cookie authenticates
+	r := httptest.NewRequest("GET", "/", nil)
+	for _, c := range callback.Result().Cookies() {
+		if c.Name == oidcSessionCookie {
+			r.AddCookie(c)
+		}
+	}
+	got, err := a.Authenticate(r)
+	if err != nil {
+		t.Fatalf("OIDCAuthenticatorImpl.Authenticate() error = %v", err)
+	}
+	want := &domain.Identity{Name: "alice@example.com", Role: "editor", Teams: []string{"infra"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OIDCAuthenticatorImpl.Authenticate() = %v, want %v", got, want)
+	}
+
+	// Tampered cookie is rejected
+	r = httptest.NewRequest("GET", "/", nil)
+	r.AddCookie(&http.Cookie{Name: oidcSessionCookie, Value: "eyJOYW1lIjoiZXZlIn0.AAAA"})
+	if _, err := a.Authenticate(r); err == nil {
+		t.Error("OIDCAuthenticatorImpl.Authenticate() accepts tampered cookie")
+	}
+}
+
+func TestOIDCAuthenticatorImpl_secureCookie(t *testing.T) {
+	provider := newOIDCStandIn(t, "firestarter", "alice@example.com")
+	defer provider.Close()
+
+	tests := []struct {
+		name        string
+		redirectURL string
+		want        bool
+	}{
+		{"http", "http://localhost:8080/auth/callback", false},
+		{"https", "https://firestarter.example.com/auth/callback", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewOIDCAuthenticatorImpl(zap.NewNop().Sugar(), provider.URL,
+				"firestarter", "client-secret", tt.redirectURL, []byte("cookie-secret"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			login := httptest.NewRecorder()
+			a.LoginHandler(login, httptest.NewRequest("GET", "/auth/login", nil))
+			location, err := url.Parse(login.Header().Get("Location"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			callbackReq := httptest.NewRequest("GET", "/auth/callback?code=code&state="+location.Query().Get("state"), nil)
+			for _, c := range login.Result().Cookies() {
+				callbackReq.AddCookie(c)
+			}
+			callback := httptest.NewRecorder()
+			a.CallbackHandler(callback, callbackReq)
+
+			cookies := append(login.Result().Cookies(), callback.Result().Cookies()...)
+			if len(cookies) != 3 {
+				t.Fatalf("OIDCAuthenticatorImpl set %d cookies, want 3", len(cookies))
+			}
+			for _, c := range cookies {
+				if c.Secure != tt.want {
+					t.Errorf("OIDCAuthenticatorImpl cookie %s Secure = %v, want %v", c.Name, c.Secure, tt.want)
+				}
+			}
+		})
+	}
+}
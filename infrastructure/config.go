@@ -24,6 +24,41 @@ type SaveConfig struct {
 	BodyTemplateString string
 	Secrets            map[string]string
 	Type               string
+	Method             string
+	ContentType        string
+	Headers            map[string]string
+	ResponseTemplate   string
+	RetryMaxAttempts   int
+	RetryBackoff       string
+	RetryStatusCodes   []int
+	Timeout            string
+	Team               string
+	Command            string
+	Reaction           string
+	Schedule           string
+	Steps              []SaveStep
+	OptionsURLTemplate string
+	Approvers          []string
+	RequiredApprovals  int
+	DenySelfApproval   bool
+	AllowedUsers       []string
+	DeniedUsers        []string
+	Priority           int
+	MatchMode          string
+	ReplyInThread      bool
+	ThreadMatch        string
+	StatusURLTemplate  string
+	StatusInterval     string
+	StatusTimeout      string
+	StatusSuccess      string
+	StatusFailure      string
+	StatusPending      string
+}
+
+type SaveStep struct {
+	Name    string
+	Text    string
+	Options []string
 }
 
 type ConfigRepositoryImpl struct {
@@ -32,6 +67,7 @@ type ConfigRepositoryImpl struct {
 	loaded        bool
 	configFile    string
 	logger        *zap.SugaredLogger
+	revision      uint64 // incremented on each change
 }
 
 func NewConfigRepositoryImpl(logger *zap.SugaredLogger) *ConfigRepositoryImpl {
@@ -144,6 +180,7 @@ func (c *ConfigRepositoryImpl) SetConfig(config *domain.Config) error {
 		return err
 	}
 
+	c.revision++
 	return nil
 }
 
@@ -165,28 +202,108 @@ func (c *ConfigRepositoryImpl) DeleteConfig(ID string) error {
 		c.currentConfig = nil
 		return err
 	}
+	c.revision++
 	return nil
 }
 
+func (c *ConfigRepositoryImpl) RestoreConfigList(configs domain.ConfigMap, merge bool) error {
+	err := c.loadConfigIfNeeded()
+	if err != nil {
+		return errors.Wrap(err, "Load config on RestoreConfigList")
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	bak := c.currentConfig
+	restored := make(map[string]*SaveConfig)
+	if merge {
+		for k, v := range bak {
+			restored[k] = v
+		}
+	}
+	for _, config := range configs {
+		// Secrets are restored as is.
+		restored[config.CallbackID] = c.configToSaveConfig(config, map[string]string{})
+	}
+	c.currentConfig = restored
+
+	// Write it to file
+	err = c.saveConfig()
+	if err != nil {
+		// rollback
+		c.currentConfig = bak
+		return err
+	}
+
+	c.revision++
+	c.logger.Infow("Config restored", zap.Int("count", len(configs)), zap.Bool("merge", merge))
+	return nil
+}
+
+func (c *ConfigRepositoryImpl) Revision() uint64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.revision
+}
+
 // Mapper
 func (c *ConfigRepositoryImpl) saveConfigToConfig(saveconfig *SaveConfig) *domain.Config {
 	config := &domain.Config{
-		Title:              saveconfig.Title,
-		CallbackID:         saveconfig.CallbackID,
-		Channels:           saveconfig.Channels,
-		RegexpString:       saveconfig.RegexpString,
-		TextTemplateString: saveconfig.Text,
-		Actions:            saveconfig.Actions,
-		URLTemplateString:  saveconfig.URLTemplateString,
-		BodyTemplateString: saveconfig.BodyTemplateString,
-		Confirm:            saveconfig.Confirm,
-		Secrets:            make(map[string]string),
+		Title:                    saveconfig.Title,
+		CallbackID:               saveconfig.CallbackID,
+		Channels:                 saveconfig.Channels,
+		RegexpString:             saveconfig.RegexpString,
+		TextTemplateString:       saveconfig.Text,
+		Actions:                  saveconfig.Actions,
+		URLTemplateString:        saveconfig.URLTemplateString,
+		BodyTemplateString:       saveconfig.BodyTemplateString,
+		Confirm:                  saveconfig.Confirm,
+		Secrets:                  make(map[string]string),
+		Method:                   saveconfig.Method,
+		ContentType:              saveconfig.ContentType,
+		Headers:                  make(map[string]string),
+		ResponseTemplateString:   saveconfig.ResponseTemplate,
+		RetryMaxAttempts:         saveconfig.RetryMaxAttempts,
+		RetryBackoffString:       saveconfig.RetryBackoff,
+		RetryStatusCodes:         saveconfig.RetryStatusCodes,
+		TimeoutString:            saveconfig.Timeout,
+		Team:                     saveconfig.Team,
+		Command:                  saveconfig.Command,
+		Reaction:                 saveconfig.Reaction,
+		ScheduleString:           saveconfig.Schedule,
+		OptionsURLTemplateString: saveconfig.OptionsURLTemplate,
+		Approvers:                saveconfig.Approvers,
+		RequiredApprovals:        saveconfig.RequiredApprovals,
+		DenySelfApproval:         saveconfig.DenySelfApproval,
+		AllowedUsers:             saveconfig.AllowedUsers,
+		DeniedUsers:              saveconfig.DeniedUsers,
+		Priority:                 saveconfig.Priority,
+		MatchMode:                saveconfig.MatchMode,
+		ReplyInThread:            saveconfig.ReplyInThread,
+		ThreadMatch:              saveconfig.ThreadMatch,
+		StatusURLTemplateString:  saveconfig.StatusURLTemplate,
+		StatusIntervalString:     saveconfig.StatusInterval,
+		StatusTimeoutString:      saveconfig.StatusTimeout,
+		StatusSuccessString:      saveconfig.StatusSuccess,
+		StatusFailureString:      saveconfig.StatusFailure,
+		StatusPendingString:      saveconfig.StatusPending,
 	}
 
 	// Deep copy
+	for _, step := range saveconfig.Steps {
+		config.Steps = append(config.Steps, domain.Step{
+			Name:    step.Name,
+			Text:    step.Text,
+			Options: step.Options,
+		})
+	}
 	for k, v := range saveconfig.Secrets {
 		config.Secrets[k] = v
 	}
+	for k, v := range saveconfig.Headers {
+		config.Headers[k] = v
+	}
 
 	config.Hydrate()
 	return config
@@ -204,6 +321,42 @@ func (c *ConfigRepositoryImpl) configToSaveConfig(config *domain.Config, oldSecr
 		BodyTemplateString: config.BodyTemplateString,
 		Confirm:            config.Confirm,
 		Secrets:            make(map[string]string),
+		Method:             config.Method,
+		ContentType:        config.ContentType,
+		Headers:            make(map[string]string),
+		ResponseTemplate:   config.ResponseTemplateString,
+		RetryMaxAttempts:   config.RetryMaxAttempts,
+		RetryBackoff:       config.RetryBackoffString,
+		RetryStatusCodes:   config.RetryStatusCodes,
+		Timeout:            config.TimeoutString,
+		Team:               config.Team,
+		Command:            config.Command,
+		Reaction:           config.Reaction,
+		Schedule:           config.ScheduleString,
+		OptionsURLTemplate: config.OptionsURLTemplateString,
+		Approvers:          config.Approvers,
+		RequiredApprovals:  config.RequiredApprovals,
+		DenySelfApproval:   config.DenySelfApproval,
+		AllowedUsers:       config.AllowedUsers,
+		DeniedUsers:        config.DeniedUsers,
+		Priority:           config.Priority,
+		MatchMode:          config.MatchMode,
+		ReplyInThread:      config.ReplyInThread,
+		ThreadMatch:        config.ThreadMatch,
+		StatusURLTemplate:  config.StatusURLTemplateString,
+		StatusInterval:     config.StatusIntervalString,
+		StatusTimeout:      config.StatusTimeoutString,
+		StatusSuccess:      config.StatusSuccessString,
+		StatusFailure:      config.StatusFailureString,
+		StatusPending:      config.StatusPendingString,
+	}
+
+	for _, step := range config.Steps {
+		saveConfig.Steps = append(saveConfig.Steps, SaveStep{
+			Name:    step.Name,
+			Text:    step.Text,
+			Options: step.Options,
+		})
 	}
 
 	for k, new := range config.Secrets {
@@ -217,6 +370,9 @@ func (c *ConfigRepositoryImpl) configToSaveConfig(config *domain.Config, oldSecr
 		saveConfig.Secrets[k] = new
 		// if value is not included in new config, just delete.
 	}
+	for k, v := range config.Headers {
+		saveConfig.Headers[k] = v
+	}
 
 	return saveConfig
 }
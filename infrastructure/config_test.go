@@ -1,14 +1,37 @@
 package infrastructure
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sync"
 	"testing"
+	"text/template"
 
 	"github.com/juntaki/firestarter/domain"
 	"go.uber.org/zap"
 )
 
+// withoutTemplates drops compiled templates to compare,
+// their template functions are never deeply equal.
+func withoutTemplates(t *testing.T, configMap domain.ConfigMap) domain.ConfigMap {
+	ret := domain.ConfigMap{}
+	for k, v := range configMap {
+		c := *v
+		for _, tmpl := range []*template.Template{c.URLTemplate, c.BodyTemplate, c.TextTemplate, c.ResponseTemplate, c.OptionsURLTemplate, c.StatusURLTemplate} {
+			if tmpl == nil {
+				t.Fatalf("template is not compiled: %s", k)
+			}
+		}
+		c.URLTemplate, c.BodyTemplate, c.TextTemplate, c.ResponseTemplate, c.OptionsURLTemplate = nil, nil, nil, nil, nil
+		c.StatusURLTemplate = nil
+		c.HeaderTemplates, c.StatusTemplates = nil, nil
+		ret[k] = &c
+	}
+	return ret
+}
+
 func TestNewConfigRepositoryImpl(t *testing.T) {
 	zapLogger, err := zap.NewProduction()
 	if err != nil {
@@ -75,6 +98,7 @@ func TestConfigRepositoryImpl_GetConfigList(t *testing.T) {
 					BodyTemplateString: "{ value : {{index .matched 1}} }",
 					TextTemplateString: "Deploy app",
 					Secrets:            map[string]string{},
+					Headers:            map[string]string{},
 				}
 				configMap["ba8oiiei1gbjr0ucqbo0"].Hydrate()
 				return configMap
@@ -117,9 +141,79 @@ func TestConfigRepositoryImpl_GetConfigList(t *testing.T) {
 				t.Errorf("ConfigRepositoryImpl.GetConfigList() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(got, tt.want) {
+			if !reflect.DeepEqual(withoutTemplates(t, got), withoutTemplates(t, tt.want)) {
 				t.Errorf("ConfigRepositoryImpl.GetConfigList() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestConfigRepositoryImpl_SetConfig_headers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := &ConfigRepositoryImpl{
+		currentConfig: make(map[string]*SaveConfig),
+		mutex:         &sync.RWMutex{},
+		loaded:        true,
+		configFile:    filepath.Join(dir, "config.json"),
+		logger:        zap.NewNop().Sugar(),
+	}
+	config := &domain.Config{
+		CallbackID:        "deploy",
+		URLTemplateString: "http://localhost",
+		Headers:           map[string]string{"X-App": "api"},
+	}
+	if err := c.SetConfig(config); err != nil {
+		t.Fatal(err)
+	}
+	// The caller modifies its config after save.
+	config.Headers["X-App"] = "web"
+
+	got, err := c.GetConfig("deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"X-App": "api"}; !reflect.DeepEqual(got.Headers, want) {
+		t.Errorf("ConfigRepositoryImpl.GetConfig() headers = %v, want %v", got.Headers, want)
+	}
+}
+
+func TestConfigRepositoryImpl_Revision(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := &ConfigRepositoryImpl{
+		currentConfig: make(map[string]*SaveConfig),
+		mutex:         &sync.RWMutex{},
+		loaded:        true,
+		configFile:    filepath.Join(dir, "config.json"),
+		logger:        zap.NewNop().Sugar(),
+	}
+	config := &domain.Config{CallbackID: "deploy", URLTemplateString: "http://localhost"}
+	for i, modify := range []func() error{
+		func() error { return c.SetConfig(config) },
+		func() error { return c.RestoreConfigList(domain.ConfigMap{"deploy": config}, false) },
+		func() error { return c.DeleteConfig("deploy") },
+	} {
+		before := c.Revision()
+		if _, err := c.GetConfigList(); err != nil {
+			t.Fatal(err)
+		}
+		if c.Revision() != before {
+			t.Errorf("%d: revision is changed by GetConfigList", i)
+		}
+		if err := modify(); err != nil {
+			t.Fatal(err)
+		}
+		if c.Revision() == before {
+			t.Errorf("%d: revision is not changed", i)
+		}
+	}
+}
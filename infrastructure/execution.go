@@ -0,0 +1,112 @@
+package infrastructure
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/juntaki/firestarter/domain"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// ExecutionRepositoryFileImpl appends executions to JSON lines file.
+type ExecutionRepositoryFileImpl struct {
+	mutex         *sync.Mutex
+	executionFile string
+	logger        *zap.SugaredLogger
+}
+
+func NewExecutionRepositoryFileImpl(logger *zap.SugaredLogger, executionFile string) *ExecutionRepositoryFileImpl {
+	return &ExecutionRepositoryFileImpl{
+		mutex:         &sync.Mutex{},
+		executionFile: executionFile,
+		logger:        logger,
+	}
+}
+
+func (e *ExecutionRepositoryFileImpl) AddExecution(execution *domain.Execution) error {
+	bytes, err := json.Marshal(execution)
+	if err != nil {
+		return errors.Wrap(err, "JSON marshal failed")
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	f, err := os.OpenFile(e.executionFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "Failed to open execution file")
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(bytes, '\n')); err != nil {
+		return errors.Wrap(err, "Failed to write execution")
+	}
+	return nil
+}
+
+func (e *ExecutionRepositoryFileImpl) GetExecution(ID string) (*domain.Execution, error) {
+	var found *domain.Execution
+	err := e.scan(func(execution *domain.Execution) {
+		if execution.ID == ID {
+			found = execution
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, errors.New("Not found")
+	}
+	return found, nil
+}
+
+func (e *ExecutionRepositoryFileImpl) ListExecutions(filter *domain.ExecutionFilter, offset, limit int) ([]*domain.Execution, error) {
+	matched := []*domain.Execution{}
+	err := e.scan(func(execution *domain.Execution) {
+		if filter.Match(execution) {
+			matched = append(matched, execution)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Newest first
+	executions := []*domain.Execution{}
+	for i := len(matched) - 1 - offset; i >= 0 && len(executions) < limit; i-- {
+		executions = append(executions, matched[i])
+	}
+	return executions, nil
+}
+
+func (e *ExecutionRepositoryFileImpl) scan(fn func(*domain.Execution)) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	f, err := os.Open(e.executionFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "Failed to open execution file")
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		execution := &domain.Execution{}
+		if err := json.Unmarshal(scanner.Bytes(), execution); err != nil {
+			e.logger.Errorw("Broken execution", zap.Error(err))
+			continue
+		}
+		fn(execution)
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.Wrap(err, "Failed to read execution file")
+	}
+	return nil
+}
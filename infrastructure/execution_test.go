@@ -0,0 +1,74 @@
+package infrastructure
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/juntaki/firestarter/domain"
+	"go.uber.org/zap"
+)
+
+func TestExecutionRepositoryFileImpl_ListExecutions(t *testing.T) {
+	zapLogger, err := zap.NewProduction()
+	if err != nil {
+		panic("logger initialize failed")
+	}
+	logger := zapLogger.Sugar()
+
+	dir, err := ioutil.TempDir("", "execution")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	repo := NewExecutionRepositoryFileImpl(logger, filepath.Join(dir, "executions.log"))
+	for _, e := range []*domain.Execution{
+		{ID: "1", ConfigID: "deploy"},
+		{ID: "2", ConfigID: "build"},
+		{ID: "3", ConfigID: "deploy"},
+		{ID: "4", ConfigID: "deploy"},
+	} {
+		if err := repo.AddExecution(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter *domain.ExecutionFilter
+		offset int
+		limit  int
+		want   []string
+	}{
+		{"all", &domain.ExecutionFilter{}, 0, 10, []string{"4", "3", "2", "1"}},
+		{"first page", &domain.ExecutionFilter{ConfigID: "deploy"}, 0, 2, []string{"4", "3"}},
+		{"last page", &domain.ExecutionFilter{ConfigID: "deploy"}, 2, 2, []string{"1"}},
+		{"out of range", &domain.ExecutionFilter{ConfigID: "deploy"}, 3, 2, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.ListExecutions(tt.filter, tt.offset, tt.limit)
+			if err != nil {
+				t.Fatalf("ExecutionRepositoryFileImpl.ListExecutions() error = %v", err)
+			}
+			ids := []string{}
+			for _, e := range got {
+				ids = append(ids, e.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("ExecutionRepositoryFileImpl.ListExecutions() = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+
+	got, err := repo.GetExecution("2")
+	if err != nil || got.ConfigID != "build" {
+		t.Errorf("ExecutionRepositoryFileImpl.GetExecution() = %v, %v", got, err)
+	}
+	if _, err := repo.GetExecution("5"); err == nil {
+		t.Errorf("ExecutionRepositoryFileImpl.GetExecution() want error")
+	}
+}
@@ -0,0 +1,148 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/juntaki/expiresync"
+	"github.com/juntaki/firestarter/domain"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// SessionStoreMemoryImpl keeps sessions on memory, they are lost on restart.
+// Sessions are copied on Get and Set, callers modify them.
+type SessionStoreMemoryImpl struct {
+	store *expiresync.Map
+}
+
+func NewSessionStoreMemoryImpl() *SessionStoreMemoryImpl {
+	return &SessionStoreMemoryImpl{
+		store: expiresync.NewMap(),
+	}
+}
+
+func (s *SessionStoreMemoryImpl) Get(ID string) (*domain.SessionValue, bool) {
+	sess, ok := s.store.Get(ID)
+	if !ok {
+		return nil, false
+	}
+	return sess.(*domain.SessionValue).Copy(), true
+}
+
+func (s *SessionStoreMemoryImpl) Set(ID string, sess *domain.SessionValue, expire time.Duration) error {
+	s.store.Set(ID, sess.Copy(), expire)
+	return nil
+}
+
+func (s *SessionStoreMemoryImpl) DeleteExpired() error {
+	s.store.DeleteExpired()
+	return nil
+}
+
+type SaveSession struct {
+	Session *domain.SessionValue
+	Expire  time.Time
+}
+
+// SessionStoreFileImpl keeps sessions in JSON file, they survive restart.
+// Sessions are copied on Get and Set, callers modify them.
+type SessionStoreFileImpl struct {
+	currentSession map[string]*SaveSession
+	mutex          *sync.RWMutex
+	sessionFile    string
+	logger         *zap.SugaredLogger
+}
+
+func NewSessionStoreFileImpl(logger *zap.SugaredLogger, sessionFile string) (*SessionStoreFileImpl, error) {
+	s := &SessionStoreFileImpl{
+		currentSession: make(map[string]*SaveSession),
+		mutex:          &sync.RWMutex{},
+		sessionFile:    sessionFile,
+		logger:         logger,
+	}
+
+	bytes, err := ioutil.ReadFile(sessionFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// Set will make new file.
+			return s, nil
+		}
+		return nil, errors.Wrap(err, "Failed to read session file")
+	}
+
+	if err := json.Unmarshal(bytes, &s.currentSession); err != nil {
+		return nil, errors.Wrap(err, "Session is invalid json")
+	}
+	logger.Infow("Session loaded", zap.Int("count", len(s.currentSession)))
+	return s, nil
+}
+
+func (s *SessionStoreFileImpl) Get(ID string) (*domain.SessionValue, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	saved, ok := s.currentSession[ID]
+	if !ok || time.Now().After(saved.Expire) {
+		return nil, false
+	}
+	return saved.Session.Copy(), true
+}
+
+func (s *SessionStoreFileImpl) Set(ID string, sess *domain.SessionValue, expire time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	bak := s.currentSession[ID]
+	s.currentSession[ID] = &SaveSession{
+		Session: sess.Copy(),
+		Expire:  time.Now().Add(expire),
+	}
+
+	err := s.saveSession()
+	if err != nil {
+		// rollback
+		if bak == nil {
+			delete(s.currentSession, ID)
+		} else {
+			s.currentSession[ID] = bak
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *SessionStoreFileImpl) DeleteExpired() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	for k, v := range s.currentSession {
+		if now.After(v.Expire) {
+			delete(s.currentSession, k)
+		}
+	}
+	return s.saveSession()
+}
+
+func (s *SessionStoreFileImpl) saveSession() error {
+	bytes, err := json.Marshal(s.currentSession)
+	if err != nil {
+		return errors.Wrap(err, "JSON marshal failed")
+	}
+
+	// Write to temporary file and rename, not to break session file on crash.
+	tmp := s.sessionFile + ".tmp"
+	err = ioutil.WriteFile(tmp, bytes, 0600)
+	if err != nil {
+		return errors.Wrap(err, "Failed to write file")
+	}
+	err = os.Rename(tmp, s.sessionFile)
+	if err != nil {
+		return errors.Wrap(err, "Failed to rename file")
+	}
+	return nil
+}
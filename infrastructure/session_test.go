@@ -0,0 +1,150 @@
+package infrastructure
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/juntaki/firestarter/domain"
+	"go.uber.org/zap"
+)
+
+func TestSessionStoreFileImpl_Reload(t *testing.T) {
+	zapLogger, err := zap.NewProduction()
+	if err != nil {
+		panic("logger initialize failed")
+	}
+	logger := zapLogger.Sugar()
+
+	dir, err := ioutil.TempDir("", "session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sessionFile := filepath.Join(dir, "session.json")
+
+	tests := []struct {
+		name   string
+		sess   *domain.SessionValue
+		expire time.Duration
+		wantOK bool
+	}{
+		{
+			name: "alive",
+			sess: &domain.SessionValue{
+				Matched: []string{"deploy master", "master"},
+				Value:   "production",
+				ID:      "alive",
+			},
+			expire: time.Hour,
+			wantOK: true,
+		},
+		{
+			name: "expired",
+			sess: &domain.SessionValue{
+				Matched: []string{"deploy"},
+				ID:      "expired",
+			},
+			expire: -time.Hour,
+			wantOK: false,
+		},
+	}
+
+	store, err := NewSessionStoreFileImpl(logger, sessionFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		if err := store.Set(tt.sess.ID, tt.sess, tt.expire); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Load from file, like restarted.
+	reloaded, err := NewSessionStoreFileImpl(logger, sessionFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := reloaded.Get(tt.sess.ID)
+			if ok != tt.wantOK {
+				t.Errorf("SessionStoreFileImpl.Get() ok = %v, want %v", ok, tt.wantOK)
+				return
+			}
+			if ok && !reflect.DeepEqual(got, tt.sess) {
+				t.Errorf("SessionStoreFileImpl.Get() = %v, want %v", got, tt.sess)
+			}
+		})
+	}
+}
+
+func TestSessionStore_copy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fileStore, err := NewSessionStoreFileImpl(zap.NewNop().Sugar(), filepath.Join(dir, "session.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		store domain.SessionStore
+	}{
+		{"memory", NewSessionStoreMemoryImpl()},
+		{"file", fileStore},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sess := &domain.SessionValue{ID: "copy", Approvals: []string{"U1"}}
+			if err := tt.store.Set(sess.ID, sess, time.Hour); err != nil {
+				t.Fatal(err)
+			}
+			sess.Approvals[0] = "U2"
+
+			got, _ := tt.store.Get(sess.ID)
+			got.Approvals = append(got.Approvals, "U3")
+			got.Select(domain.Step{Name: "env"}, "staging")
+
+			got, _ = tt.store.Get(sess.ID)
+			want := &domain.SessionValue{ID: "copy", Approvals: []string{"U1"}}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Get() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestSessionStoreFileImpl_rollback(t *testing.T) {
+	dir, err := ioutil.TempDir("", "session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := NewSessionStoreFileImpl(zap.NewNop().Sugar(), filepath.Join(dir, "session.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Set("rollback", &domain.SessionValue{ID: "rollback"}, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	// Session file can't be written.
+	store.sessionFile = filepath.Join(dir, "missing", "session.json")
+	sess, _ := store.Get("rollback")
+	sess.Approvals = []string{"U1"}
+	if err := store.Set("rollback", sess, time.Hour); err == nil {
+		t.Fatal("SessionStoreFileImpl.Set() error = nil, want error")
+	}
+
+	got, _ := store.Get("rollback")
+	if len(got.Approvals) != 0 {
+		t.Errorf("SessionStoreFileImpl.Get() approvals = %v, want rolled back", got.Approvals)
+	}
+}
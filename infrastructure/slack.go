@@ -22,3 +22,26 @@ func (c *ChatRepositorySlackImpl) GetChannels() (domain.Channels, error) {
 	}
 	return ret, nil
 }
+
+func (c *ChatRepositorySlackImpl) GetMembers() (domain.Members, error) {
+	users, err := c.API.GetUsers()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get users")
+	}
+	groups, err := c.API.GetUserGroups()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get user groups")
+	}
+
+	ret := make(domain.Members, 0, len(users)+len(groups))
+	for _, u := range users {
+		if u.Deleted || u.IsBot {
+			continue
+		}
+		ret = append(ret, domain.Member{ID: u.ID, Name: u.Name})
+	}
+	for _, g := range groups {
+		ret = append(ret, domain.Member{ID: g.ID, Name: g.Handle, Group: true})
+	}
+	return ret, nil
+}
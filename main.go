@@ -13,6 +13,7 @@ import (
 	"github.com/go-chi/chi/middleware"
 	"github.com/juntaki/firestarter-sqs-proxy/lib"
 	"github.com/juntaki/firestarter/application"
+	"github.com/juntaki/firestarter/domain"
 	"github.com/juntaki/firestarter/infrastructure"
 	proto "github.com/juntaki/firestarter/proto"
 )
@@ -30,9 +31,12 @@ func main() {
 		logger.Error("SLACK_TOKEN is required")
 	}
 	verificationToken := os.Getenv("SLACK_VERIFICATION_TOKEN")
-	if len(verificationToken) == 0 {
-		logger.Error("SLACK_VERIFICATION_TOKEN is required")
+	signingSecret := os.Getenv("SLACK_SIGNING_SECRET")
+	if len(verificationToken) == 0 && len(signingSecret) == 0 {
+		logger.Error("SLACK_SIGNING_SECRET or SLACK_VERIFICATION_TOKEN is required")
 	}
+	// Receive messages by Events API instead of RTM
+	eventsAPIMode := len(os.Getenv("SLACK_EVENTS_API")) != 0
 
 	// SQS mode enabled?
 	var proxy *lib.SQSProxy
@@ -45,10 +49,26 @@ func main() {
 		}
 	}
 
+	// Socket Mode enabled? Events and interactive messages come over websocket.
+	appToken := os.Getenv("SLACK_APP_TOKEN")
+	socketMode := len(appToken) != 0
+
 	// Dependent modules
 	slackAPI := slack.New(token)
 	configRepository := infrastructure.NewConfigRepositoryImpl(logger)
 	chatRepository := &infrastructure.ChatRepositorySlackImpl{API: slackAPI}
+	auditRepository := infrastructure.NewAuditRepositoryFileImpl(logger, "config/audit.log")
+	executionRepository := infrastructure.NewExecutionRepositoryFileImpl(logger, "config/executions.log")
+
+	// Sessions are on memory by default, set SESSION_FILE to keep them on restart.
+	var sessionStore domain.SessionStore = infrastructure.NewSessionStoreMemoryImpl()
+	sessionFile := os.Getenv("SESSION_FILE")
+	if len(sessionFile) != 0 {
+		sessionStore, err = infrastructure.NewSessionStoreFileImpl(logger, sessionFile)
+		if err != nil {
+			logger.Fatalw("session store", zap.Error(err))
+		}
+	}
 
 	// Middleware
 	botRouter := chi.NewRouter()
@@ -69,30 +89,88 @@ func main() {
 	// Interarcitve message API, Slack <-> bot
 	bot := application.NewSlackBot(
 		verificationToken,
+		signingSecret,
 		slackAPI,
 		configRepository,
+		auditRepository,
+		executionRepository,
 		logger,
-		sqsMode,
+		sessionStore,
+		sqsMode || socketMode,
 	)
 	botRouter.Post("/", bot.InteractiveMessageHandler)
+	botRouter.Post("/events", bot.EventsHandler)
+	botRouter.Post("/commands", bot.CommandHandler)
+	botRouter.Post("/hooks/{configID}", bot.WebhookHandler)
 
 	// admin API, admin <-> firestarter
 	adminAPI := application.NewAdminAPI(
 		configRepository,
 		chatRepository,
+		auditRepository,
+		executionRepository,
+		logger,
 	)
 	apiHandler := proto.NewConfigServiceServer(adminAPI, nil)
-	adminRouter.Mount("/twirp/", apiHandler)
 
-	// Static files
-	adminRouter.Mount("/", http.FileServer(http.Dir("admin/dist")))
-	adminRouter.Mount("/swagger-ui/",
-		http.StripPrefix("/swagger-ui/", http.FileServer(http.Dir("swagger-ui"))))
+	// Admin authentication, enabled if any authenticator is configured.
+	authenticators := []application.Authenticator{}
+	loginURL := ""
+	basicRealm := ""
+	if tokens := os.Getenv("ADMIN_TOKENS"); len(tokens) != 0 {
+		tokenAuthenticator, err := infrastructure.NewTokenAuthenticatorImpl(tokens)
+		if err != nil {
+			logger.Fatalw("token authenticator", zap.Error(err))
+		}
+		authenticators = append(authenticators, tokenAuthenticator)
+	}
+	if userFile := os.Getenv("ADMIN_USER_FILE"); len(userFile) != 0 {
+		basicAuthenticator, err := infrastructure.NewBasicAuthenticatorImpl(userFile)
+		if err != nil {
+			logger.Fatalw("basic authenticator", zap.Error(err))
+		}
+		authenticators = append(authenticators, basicAuthenticator)
+		basicRealm = "firestarter"
+	}
+	if issuer := os.Getenv("OIDC_ISSUER"); len(issuer) != 0 {
+		oidcAuthenticator, err := infrastructure.NewOIDCAuthenticatorImpl(
+			logger,
+			issuer,
+			os.Getenv("OIDC_CLIENT_ID"),
+			os.Getenv("OIDC_CLIENT_SECRET"),
+			os.Getenv("OIDC_REDIRECT_URL"),
+			[]byte(os.Getenv("ADMIN_COOKIE_SECRET")),
+		)
+		if err != nil {
+			logger.Fatalw("OIDC authenticator", zap.Error(err))
+		}
+		authenticators = append(authenticators, oidcAuthenticator)
+		adminRouter.Get("/auth/login", oidcAuthenticator.LoginHandler)
+		adminRouter.Get("/auth/callback", oidcAuthenticator.CallbackHandler)
+		loginURL = "/auth/login"
+	}
+
+	adminRouter.Group(func(r chi.Router) {
+		if len(authenticators) == 0 {
+			logger.Warn("Admin authentication is disabled, everyone is owner")
+			authenticators = append(authenticators, &application.AnonymousAuthenticator{})
+		}
+		r.Use(application.NewAuthMiddleware(logger, loginURL, basicRealm, authenticators...).Handler)
+
+		r.Mount("/twirp/", apiHandler)
+
+		// Static files
+		r.Mount("/", http.FileServer(http.Dir("admin/dist")))
+		r.Mount("/swagger-ui/",
+			http.StripPrefix("/swagger-ui/", http.FileServer(http.Dir("swagger-ui"))))
+	})
 
 	// Start servers
 	eg := errgroup.Group{}
-	// start RTM event checker
-	go bot.Run()
+	// start RTM event checker, unless messages come from Events API or Socket Mode.
+	if !eventsAPIMode && !socketMode {
+		go bot.Run()
+	}
 	// start HTTP server for interactive message.
 	eg.Go(func() error { return http.ListenAndServe(":3000", botRouter) })
 	// start HTTP server for admin.
@@ -101,6 +179,12 @@ func main() {
 	if sqsMode {
 		eg.Go(func() error { return proxy.Run() })
 	}
+	// start scheduler for configs with schedule
+	eg.Go(application.NewScheduler(bot, logger).Run)
+	// start Socket Mode client, if enabled
+	if socketMode {
+		eg.Go(application.NewSocketMode(appToken, bot, logger).Run)
+	}
 
 	err = eg.Wait()
 	if err != nil {
@@ -16,11 +16,25 @@ It has these top-level messages:
 	DumpConfigListRequest
 	RestoreConfigListRequest
 	RestoreConfigListResponse
+	ListAuditEventsRequest
+	AuditEvent
+	AuditEventList
+	GetExecutionsRequest
+	GetExecutionRequest
+	Execution
+	ExecutionList
+	TestConfigRequest
+	TestConfigResponse
 	Secret
+	Header
+	Step
 	Config
 	ConfigList
 	Channels
 	GetChannelsRequest
+	Member
+	Members
+	GetMembersRequest
 */
 package firestarter
 
@@ -114,6 +128,7 @@ func (m *DumpConfigListRequest) GetPassword() string {
 type RestoreConfigListRequest struct {
 	Password   string      `protobuf:"bytes,1,opt,name=password" json:"password,omitempty"`
 	ConfigList *ConfigList `protobuf:"bytes,2,opt,name=configList" json:"configList,omitempty"`
+	Merge      bool        `protobuf:"varint,3,opt,name=merge" json:"merge,omitempty"`
 }
 
 func (m *RestoreConfigListRequest) Reset()                    { *m = RestoreConfigListRequest{} }
@@ -135,6 +150,13 @@ func (m *RestoreConfigListRequest) GetConfigList() *ConfigList {
 	return nil
 }
 
+func (m *RestoreConfigListRequest) GetMerge() bool {
+	if m != nil {
+		return m.Merge
+	}
+	return false
+}
+
 type RestoreConfigListResponse struct {
 }
 
@@ -143,6 +165,438 @@ func (m *RestoreConfigListResponse) String() string            { return proto.Co
 func (*RestoreConfigListResponse) ProtoMessage()               {}
 func (*RestoreConfigListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
+type ListAuditEventsRequest struct {
+	ConfigID string `protobuf:"bytes,1,opt,name=ConfigID" json:"ConfigID,omitempty"`
+	Since    int64  `protobuf:"varint,2,opt,name=Since" json:"Since,omitempty"`
+	Until    int64  `protobuf:"varint,3,opt,name=Until" json:"Until,omitempty"`
+}
+
+func (m *ListAuditEventsRequest) Reset()                    { *m = ListAuditEventsRequest{} }
+func (m *ListAuditEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListAuditEventsRequest) ProtoMessage()               {}
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *ListAuditEventsRequest) GetConfigID() string {
+	if m != nil {
+		return m.ConfigID
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *ListAuditEventsRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+type AuditEvent struct {
+	ID       string   `protobuf:"bytes,1,opt,name=ID" json:"ID,omitempty"`
+	Time     int64    `protobuf:"varint,2,opt,name=Time" json:"Time,omitempty"`
+	Actor    string   `protobuf:"bytes,3,opt,name=Actor" json:"Actor,omitempty"`
+	Action   string   `protobuf:"bytes,4,opt,name=Action" json:"Action,omitempty"`
+	ConfigID string   `protobuf:"bytes,5,opt,name=ConfigID" json:"ConfigID,omitempty"`
+	Diff     []string `protobuf:"bytes,6,rep,name=Diff" json:"Diff,omitempty"`
+	Detail   string   `protobuf:"bytes,7,opt,name=Detail" json:"Detail,omitempty"`
+	Outcome  string   `protobuf:"bytes,8,opt,name=Outcome" json:"Outcome,omitempty"`
+}
+
+func (m *AuditEvent) Reset()                    { *m = AuditEvent{} }
+func (m *AuditEvent) String() string            { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()               {}
+func (*AuditEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *AuditEvent) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetConfigID() string {
+	if m != nil {
+		return m.ConfigID
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetDiff() []string {
+	if m != nil {
+		return m.Diff
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+type AuditEventList struct {
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=Events" json:"Events,omitempty"`
+}
+
+func (m *AuditEventList) Reset()                    { *m = AuditEventList{} }
+func (m *AuditEventList) String() string            { return proto.CompactTextString(m) }
+func (*AuditEventList) ProtoMessage()               {}
+func (*AuditEventList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *AuditEventList) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type GetExecutionsRequest struct {
+	ConfigID  string `protobuf:"bytes,1,opt,name=ConfigID" json:"ConfigID,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=PageSize" json:"PageSize,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=PageToken" json:"PageToken,omitempty"`
+}
+
+func (m *GetExecutionsRequest) Reset()                    { *m = GetExecutionsRequest{} }
+func (m *GetExecutionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetExecutionsRequest) ProtoMessage()               {}
+func (*GetExecutionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *GetExecutionsRequest) GetConfigID() string {
+	if m != nil {
+		return m.ConfigID
+	}
+	return ""
+}
+
+func (m *GetExecutionsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetExecutionsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type GetExecutionRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=ID" json:"ID,omitempty"`
+}
+
+func (m *GetExecutionRequest) Reset()                    { *m = GetExecutionRequest{} }
+func (m *GetExecutionRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetExecutionRequest) ProtoMessage()               {}
+func (*GetExecutionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *GetExecutionRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+type Execution struct {
+	ID         string `protobuf:"bytes,1,opt,name=ID" json:"ID,omitempty"`
+	Time       int64  `protobuf:"varint,2,opt,name=Time" json:"Time,omitempty"`
+	SessionID  string `protobuf:"bytes,3,opt,name=SessionID" json:"SessionID,omitempty"`
+	ConfigID   string `protobuf:"bytes,4,opt,name=ConfigID" json:"ConfigID,omitempty"`
+	User       string `protobuf:"bytes,5,opt,name=User" json:"User,omitempty"`
+	Channel    string `protobuf:"bytes,6,opt,name=Channel" json:"Channel,omitempty"`
+	Method     string `protobuf:"bytes,7,opt,name=Method" json:"Method,omitempty"`
+	URL        string `protobuf:"bytes,8,opt,name=URL" json:"URL,omitempty"`
+	Body       string `protobuf:"bytes,9,opt,name=Body" json:"Body,omitempty"`
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode" json:"StatusCode,omitempty"`
+	LatencyMs  int64  `protobuf:"varint,11,opt,name=LatencyMs" json:"LatencyMs,omitempty"`
+	Error      string `protobuf:"bytes,12,opt,name=Error" json:"Error,omitempty"`
+}
+
+func (m *Execution) Reset()                    { *m = Execution{} }
+func (m *Execution) String() string            { return proto.CompactTextString(m) }
+func (*Execution) ProtoMessage()               {}
+func (*Execution) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *Execution) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Execution) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *Execution) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *Execution) GetConfigID() string {
+	if m != nil {
+		return m.ConfigID
+	}
+	return ""
+}
+
+func (m *Execution) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Execution) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *Execution) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Execution) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *Execution) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *Execution) GetStatusCode() int32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *Execution) GetLatencyMs() int64 {
+	if m != nil {
+		return m.LatencyMs
+	}
+	return 0
+}
+
+func (m *Execution) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ExecutionList struct {
+	Executions    []*Execution `protobuf:"bytes,1,rep,name=Executions" json:"Executions,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=NextPageToken" json:"NextPageToken,omitempty"`
+}
+
+func (m *ExecutionList) Reset()                    { *m = ExecutionList{} }
+func (m *ExecutionList) String() string            { return proto.CompactTextString(m) }
+func (*ExecutionList) ProtoMessage()               {}
+func (*ExecutionList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *ExecutionList) GetExecutions() []*Execution {
+	if m != nil {
+		return m.Executions
+	}
+	return nil
+}
+
+func (m *ExecutionList) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type TestConfigRequest struct {
+	Config  *Config `protobuf:"bytes,1,opt,name=Config" json:"Config,omitempty"`
+	Message string  `protobuf:"bytes,2,opt,name=Message" json:"Message,omitempty"`
+	Channel string  `protobuf:"bytes,3,opt,name=Channel" json:"Channel,omitempty"`
+	Value   string  `protobuf:"bytes,4,opt,name=Value" json:"Value,omitempty"`
+	Send    bool    `protobuf:"varint,5,opt,name=Send" json:"Send,omitempty"`
+}
+
+func (m *TestConfigRequest) Reset()                    { *m = TestConfigRequest{} }
+func (m *TestConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*TestConfigRequest) ProtoMessage()               {}
+func (*TestConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *TestConfigRequest) GetConfig() *Config {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *TestConfigRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *TestConfigRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *TestConfigRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TestConfigRequest) GetSend() bool {
+	if m != nil {
+		return m.Send
+	}
+	return false
+}
+
+type TestConfigResponse struct {
+	Matched      bool      `protobuf:"varint,1,opt,name=Matched" json:"Matched,omitempty"`
+	Groups       []string  `protobuf:"bytes,2,rep,name=Groups" json:"Groups,omitempty"`
+	Text         string    `protobuf:"bytes,3,opt,name=Text" json:"Text,omitempty"`
+	URL          string    `protobuf:"bytes,4,opt,name=URL" json:"URL,omitempty"`
+	Body         string    `protobuf:"bytes,5,opt,name=Body" json:"Body,omitempty"`
+	Headers      []*Header `protobuf:"bytes,6,rep,name=Headers" json:"Headers,omitempty"`
+	StatusCode   int32     `protobuf:"varint,7,opt,name=StatusCode" json:"StatusCode,omitempty"`
+	ResponseBody string    `protobuf:"bytes,8,opt,name=ResponseBody" json:"ResponseBody,omitempty"`
+	Response     string    `protobuf:"bytes,9,opt,name=Response" json:"Response,omitempty"`
+	Error        string    `protobuf:"bytes,10,opt,name=Error" json:"Error,omitempty"`
+}
+
+func (m *TestConfigResponse) Reset()                    { *m = TestConfigResponse{} }
+func (m *TestConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*TestConfigResponse) ProtoMessage()               {}
+func (*TestConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *TestConfigResponse) GetMatched() bool {
+	if m != nil {
+		return m.Matched
+	}
+	return false
+}
+
+func (m *TestConfigResponse) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *TestConfigResponse) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *TestConfigResponse) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *TestConfigResponse) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *TestConfigResponse) GetHeaders() []*Header {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *TestConfigResponse) GetStatusCode() int32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *TestConfigResponse) GetResponseBody() string {
+	if m != nil {
+		return m.ResponseBody
+	}
+	return ""
+}
+
+func (m *TestConfigResponse) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+func (m *TestConfigResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type Secret struct {
 	Key   string `protobuf:"bytes,1,opt,name=Key" json:"Key,omitempty"`
 	Value string `protobuf:"bytes,2,opt,name=Value" json:"Value,omitempty"`
@@ -151,7 +605,7 @@ type Secret struct {
 func (m *Secret) Reset()                    { *m = Secret{} }
 func (m *Secret) String() string            { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()               {}
-func (*Secret) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*Secret) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *Secret) GetKey() string {
 	if m != nil {
@@ -167,23 +621,108 @@ func (m *Secret) GetValue() string {
 	return ""
 }
 
+type Header struct {
+	Key   string `protobuf:"bytes,1,opt,name=Key" json:"Key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=Value" json:"Value,omitempty"`
+}
+
+func (m *Header) Reset()                    { *m = Header{} }
+func (m *Header) String() string            { return proto.CompactTextString(m) }
+func (*Header) ProtoMessage()               {}
+func (*Header) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *Header) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Header) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type Step struct {
+	Name    string   `protobuf:"bytes,1,opt,name=Name" json:"Name,omitempty"`
+	Text    string   `protobuf:"bytes,2,opt,name=Text" json:"Text,omitempty"`
+	Options []string `protobuf:"bytes,3,rep,name=Options" json:"Options,omitempty"`
+}
+
+func (m *Step) Reset()                    { *m = Step{} }
+func (m *Step) String() string            { return proto.CompactTextString(m) }
+func (*Step) ProtoMessage()               {}
+func (*Step) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *Step) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Step) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *Step) GetOptions() []string {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 type Config struct {
-	Title        string    `protobuf:"bytes,1,opt,name=Title" json:"Title,omitempty"`
-	ID           string    `protobuf:"bytes,2,opt,name=ID" json:"ID,omitempty"`
-	Channels     []string  `protobuf:"bytes,3,rep,name=Channels" json:"Channels,omitempty"`
-	TextTemplate string    `protobuf:"bytes,4,opt,name=TextTemplate" json:"TextTemplate,omitempty"`
-	Regexp       string    `protobuf:"bytes,5,opt,name=Regexp" json:"Regexp,omitempty"`
-	URLTemplate  string    `protobuf:"bytes,6,opt,name=URLTemplate" json:"URLTemplate,omitempty"`
-	BodyTemplate string    `protobuf:"bytes,7,opt,name=BodyTemplate" json:"BodyTemplate,omitempty"`
-	Confirm      bool      `protobuf:"varint,8,opt,name=Confirm" json:"Confirm,omitempty"`
-	Actions      []string  `protobuf:"bytes,9,rep,name=Actions" json:"Actions,omitempty"`
-	Secrets      []*Secret `protobuf:"bytes,10,rep,name=Secrets" json:"Secrets,omitempty"`
+	Title             string    `protobuf:"bytes,1,opt,name=Title" json:"Title,omitempty"`
+	ID                string    `protobuf:"bytes,2,opt,name=ID" json:"ID,omitempty"`
+	Channels          []string  `protobuf:"bytes,3,rep,name=Channels" json:"Channels,omitempty"`
+	TextTemplate      string    `protobuf:"bytes,4,opt,name=TextTemplate" json:"TextTemplate,omitempty"`
+	Regexp            string    `protobuf:"bytes,5,opt,name=Regexp" json:"Regexp,omitempty"`
+	URLTemplate       string    `protobuf:"bytes,6,opt,name=URLTemplate" json:"URLTemplate,omitempty"`
+	BodyTemplate      string    `protobuf:"bytes,7,opt,name=BodyTemplate" json:"BodyTemplate,omitempty"`
+	Confirm           bool      `protobuf:"varint,8,opt,name=Confirm" json:"Confirm,omitempty"`
+	Actions           []string  `protobuf:"bytes,9,rep,name=Actions" json:"Actions,omitempty"`
+	Secrets           []*Secret `protobuf:"bytes,10,rep,name=Secrets" json:"Secrets,omitempty"`
+	Method            string    `protobuf:"bytes,11,opt,name=Method" json:"Method,omitempty"`
+	ContentType       string    `protobuf:"bytes,12,opt,name=ContentType" json:"ContentType,omitempty"`
+	Headers           []*Header `protobuf:"bytes,13,rep,name=Headers" json:"Headers,omitempty"`
+	ResponseTemplate  string    `protobuf:"bytes,14,opt,name=ResponseTemplate" json:"ResponseTemplate,omitempty"`
+	RetryMaxAttempts  int32     `protobuf:"varint,15,opt,name=RetryMaxAttempts" json:"RetryMaxAttempts,omitempty"`
+	RetryBackoff      string    `protobuf:"bytes,16,opt,name=RetryBackoff" json:"RetryBackoff,omitempty"`
+	RetryStatusCodes  []int32   `protobuf:"varint,17,rep,packed,name=RetryStatusCodes" json:"RetryStatusCodes,omitempty"`
+	Timeout           string    `protobuf:"bytes,18,opt,name=Timeout" json:"Timeout,omitempty"`
+	Team              string    `protobuf:"bytes,19,opt,name=Team" json:"Team,omitempty"`
+	Command           string    `protobuf:"bytes,20,opt,name=Command" json:"Command,omitempty"`
+	Reaction          string    `protobuf:"bytes,21,opt,name=Reaction" json:"Reaction,omitempty"`
+	Schedule          string    `protobuf:"bytes,22,opt,name=Schedule" json:"Schedule,omitempty"`
+	Steps             []*Step   `protobuf:"bytes,23,rep,name=Steps" json:"Steps,omitempty"`
+	OptionsURL        string    `protobuf:"bytes,24,opt,name=OptionsURL" json:"OptionsURL,omitempty"`
+	Approvers         []string  `protobuf:"bytes,25,rep,name=Approvers" json:"Approvers,omitempty"`
+	RequiredApprovals int32     `protobuf:"varint,26,opt,name=RequiredApprovals" json:"RequiredApprovals,omitempty"`
+	DenySelfApproval  bool      `protobuf:"varint,27,opt,name=DenySelfApproval" json:"DenySelfApproval,omitempty"`
+	AllowedUsers      []string  `protobuf:"bytes,28,rep,name=AllowedUsers" json:"AllowedUsers,omitempty"`
+	DeniedUsers       []string  `protobuf:"bytes,29,rep,name=DeniedUsers" json:"DeniedUsers,omitempty"`
+	Priority          int32     `protobuf:"varint,30,opt,name=Priority" json:"Priority,omitempty"`
+	MatchMode         string    `protobuf:"bytes,31,opt,name=MatchMode" json:"MatchMode,omitempty"`
+	ReplyInThread     bool      `protobuf:"varint,32,opt,name=ReplyInThread" json:"ReplyInThread,omitempty"`
+	ThreadMatch       string    `protobuf:"bytes,33,opt,name=ThreadMatch" json:"ThreadMatch,omitempty"`
+	StatusURL         string    `protobuf:"bytes,34,opt,name=StatusURL" json:"StatusURL,omitempty"`
+	StatusInterval    string    `protobuf:"bytes,35,opt,name=StatusInterval" json:"StatusInterval,omitempty"`
+	StatusTimeout     string    `protobuf:"bytes,36,opt,name=StatusTimeout" json:"StatusTimeout,omitempty"`
+	StatusSuccess     string    `protobuf:"bytes,37,opt,name=StatusSuccess" json:"StatusSuccess,omitempty"`
+	StatusFailure     string    `protobuf:"bytes,38,opt,name=StatusFailure" json:"StatusFailure,omitempty"`
+	StatusPending     string    `protobuf:"bytes,39,opt,name=StatusPending" json:"StatusPending,omitempty"`
 }
 
 func (m *Config) Reset()                    { *m = Config{} }
 func (m *Config) String() string            { return proto.CompactTextString(m) }
 func (*Config) ProtoMessage()               {}
-func (*Config) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*Config) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *Config) GetTitle() string {
 	if m != nil {
@@ -255,6 +794,209 @@ func (m *Config) GetSecrets() []*Secret {
 	return nil
 }
 
+func (m *Config) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Config) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *Config) GetHeaders() []*Header {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *Config) GetResponseTemplate() string {
+	if m != nil {
+		return m.ResponseTemplate
+	}
+	return ""
+}
+
+func (m *Config) GetRetryMaxAttempts() int32 {
+	if m != nil {
+		return m.RetryMaxAttempts
+	}
+	return 0
+}
+
+func (m *Config) GetRetryBackoff() string {
+	if m != nil {
+		return m.RetryBackoff
+	}
+	return ""
+}
+
+func (m *Config) GetRetryStatusCodes() []int32 {
+	if m != nil {
+		return m.RetryStatusCodes
+	}
+	return nil
+}
+
+func (m *Config) GetTimeout() string {
+	if m != nil {
+		return m.Timeout
+	}
+	return ""
+}
+
+func (m *Config) GetTeam() string {
+	if m != nil {
+		return m.Team
+	}
+	return ""
+}
+
+func (m *Config) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *Config) GetReaction() string {
+	if m != nil {
+		return m.Reaction
+	}
+	return ""
+}
+
+func (m *Config) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *Config) GetSteps() []*Step {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *Config) GetOptionsURL() string {
+	if m != nil {
+		return m.OptionsURL
+	}
+	return ""
+}
+
+func (m *Config) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
+}
+
+func (m *Config) GetRequiredApprovals() int32 {
+	if m != nil {
+		return m.RequiredApprovals
+	}
+	return 0
+}
+
+func (m *Config) GetDenySelfApproval() bool {
+	if m != nil {
+		return m.DenySelfApproval
+	}
+	return false
+}
+
+func (m *Config) GetAllowedUsers() []string {
+	if m != nil {
+		return m.AllowedUsers
+	}
+	return nil
+}
+
+func (m *Config) GetDeniedUsers() []string {
+	if m != nil {
+		return m.DeniedUsers
+	}
+	return nil
+}
+
+func (m *Config) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Config) GetMatchMode() string {
+	if m != nil {
+		return m.MatchMode
+	}
+	return ""
+}
+
+func (m *Config) GetReplyInThread() bool {
+	if m != nil {
+		return m.ReplyInThread
+	}
+	return false
+}
+
+func (m *Config) GetThreadMatch() string {
+	if m != nil {
+		return m.ThreadMatch
+	}
+	return ""
+}
+
+func (m *Config) GetStatusURL() string {
+	if m != nil {
+		return m.StatusURL
+	}
+	return ""
+}
+
+func (m *Config) GetStatusInterval() string {
+	if m != nil {
+		return m.StatusInterval
+	}
+	return ""
+}
+
+func (m *Config) GetStatusTimeout() string {
+	if m != nil {
+		return m.StatusTimeout
+	}
+	return ""
+}
+
+func (m *Config) GetStatusSuccess() string {
+	if m != nil {
+		return m.StatusSuccess
+	}
+	return ""
+}
+
+func (m *Config) GetStatusFailure() string {
+	if m != nil {
+		return m.StatusFailure
+	}
+	return ""
+}
+
+func (m *Config) GetStatusPending() string {
+	if m != nil {
+		return m.StatusPending
+	}
+	return ""
+}
+
 type ConfigList struct {
 	Config []*Config `protobuf:"bytes,1,rep,name=config" json:"config,omitempty"`
 }
@@ -262,7 +1004,7 @@ type ConfigList struct {
 func (m *ConfigList) Reset()                    { *m = ConfigList{} }
 func (m *ConfigList) String() string            { return proto.CompactTextString(m) }
 func (*ConfigList) ProtoMessage()               {}
-func (*ConfigList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*ConfigList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *ConfigList) GetConfig() []*Config {
 	if m != nil {
@@ -278,7 +1020,7 @@ type Channels struct {
 func (m *Channels) Reset()                    { *m = Channels{} }
 func (m *Channels) String() string            { return proto.CompactTextString(m) }
 func (*Channels) ProtoMessage()               {}
-func (*Channels) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*Channels) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *Channels) GetList() []string {
 	if m != nil {
@@ -293,7 +1035,63 @@ type GetChannelsRequest struct {
 func (m *GetChannelsRequest) Reset()                    { *m = GetChannelsRequest{} }
 func (m *GetChannelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetChannelsRequest) ProtoMessage()               {}
-func (*GetChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*GetChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+type Member struct {
+	ID    string `protobuf:"bytes,1,opt,name=ID" json:"ID,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=Name" json:"Name,omitempty"`
+	Group bool   `protobuf:"varint,3,opt,name=Group" json:"Group,omitempty"`
+}
+
+func (m *Member) Reset()                    { *m = Member{} }
+func (m *Member) String() string            { return proto.CompactTextString(m) }
+func (*Member) ProtoMessage()               {}
+func (*Member) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *Member) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Member) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Member) GetGroup() bool {
+	if m != nil {
+		return m.Group
+	}
+	return false
+}
+
+type Members struct {
+	List []*Member `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
+}
+
+func (m *Members) Reset()                    { *m = Members{} }
+func (m *Members) String() string            { return proto.CompactTextString(m) }
+func (*Members) ProtoMessage()               {}
+func (*Members) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *Members) GetList() []*Member {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+type GetMembersRequest struct {
+}
+
+func (m *GetMembersRequest) Reset()                    { *m = GetMembersRequest{} }
+func (m *GetMembersRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMembersRequest) ProtoMessage()               {}
+func (*GetMembersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func init() {
 	proto.RegisterType((*GetConfigRequest)(nil), "firestarter.GetConfigRequest")
@@ -304,47 +1102,127 @@ func init() {
 	proto.RegisterType((*DumpConfigListRequest)(nil), "firestarter.DumpConfigListRequest")
 	proto.RegisterType((*RestoreConfigListRequest)(nil), "firestarter.RestoreConfigListRequest")
 	proto.RegisterType((*RestoreConfigListResponse)(nil), "firestarter.RestoreConfigListResponse")
+	proto.RegisterType((*ListAuditEventsRequest)(nil), "firestarter.ListAuditEventsRequest")
+	proto.RegisterType((*AuditEvent)(nil), "firestarter.AuditEvent")
+	proto.RegisterType((*AuditEventList)(nil), "firestarter.AuditEventList")
+	proto.RegisterType((*GetExecutionsRequest)(nil), "firestarter.GetExecutionsRequest")
+	proto.RegisterType((*GetExecutionRequest)(nil), "firestarter.GetExecutionRequest")
+	proto.RegisterType((*Execution)(nil), "firestarter.Execution")
+	proto.RegisterType((*ExecutionList)(nil), "firestarter.ExecutionList")
+	proto.RegisterType((*TestConfigRequest)(nil), "firestarter.TestConfigRequest")
+	proto.RegisterType((*TestConfigResponse)(nil), "firestarter.TestConfigResponse")
 	proto.RegisterType((*Secret)(nil), "firestarter.Secret")
+	proto.RegisterType((*Header)(nil), "firestarter.Header")
+	proto.RegisterType((*Step)(nil), "firestarter.Step")
 	proto.RegisterType((*Config)(nil), "firestarter.Config")
 	proto.RegisterType((*ConfigList)(nil), "firestarter.ConfigList")
 	proto.RegisterType((*Channels)(nil), "firestarter.Channels")
 	proto.RegisterType((*GetChannelsRequest)(nil), "firestarter.GetChannelsRequest")
+	proto.RegisterType((*Member)(nil), "firestarter.Member")
+	proto.RegisterType((*Members)(nil), "firestarter.Members")
+	proto.RegisterType((*GetMembersRequest)(nil), "firestarter.GetMembersRequest")
 }
 
 func init() { proto.RegisterFile("config.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0xe3, 0xd6, 0x8d, 0xc7, 0x29, 0x2a, 0x93, 0xb4, 0x2c, 0x46, 0x14, 0x77, 0x25, 0xa4,
-	0x48, 0x88, 0x08, 0xa5, 0x07, 0xc4, 0xb1, 0x6d, 0xa4, 0xaa, 0xa2, 0x5c, 0x9c, 0x94, 0xbb, 0x71,
-	0xa7, 0xc5, 0x92, 0x63, 0x1b, 0xef, 0x06, 0xda, 0x0f, 0xe0, 0x7b, 0xf8, 0x45, 0xe4, 0xf5, 0xda,
-	0xb1, 0x5b, 0x03, 0xe2, 0xe6, 0xd9, 0x79, 0xef, 0xcd, 0xce, 0xdb, 0x19, 0xc3, 0x30, 0x4c, 0x93,
-	0x9b, 0xe8, 0x76, 0x9a, 0xe5, 0xa9, 0x4c, 0xd1, 0xb9, 0x89, 0x72, 0x12, 0x32, 0xc8, 0x25, 0xe5,
-	0x9c, 0xc3, 0xde, 0x39, 0xc9, 0x33, 0x95, 0xf7, 0xe9, 0xdb, 0x9a, 0x84, 0xc4, 0x27, 0xd0, 0xbf,
-	0x98, 0x33, 0xc3, 0x33, 0x26, 0xb6, 0xdf, 0xbf, 0x98, 0xf3, 0x03, 0x18, 0xd7, 0x98, 0xcb, 0x48,
-	0x48, 0x8d, 0xe3, 0x23, 0x78, 0xba, 0xd8, 0x70, 0x45, 0x96, 0x26, 0x82, 0xf8, 0x6b, 0x18, 0xcd,
-	0x29, 0x26, 0x49, 0xff, 0xd4, 0x6c, 0xc3, 0x34, 0xfd, 0x18, 0xf6, 0xe7, 0xeb, 0x55, 0xf6, 0xa8,
-	0x18, 0xba, 0x30, 0xc8, 0x02, 0x21, 0x7e, 0xa4, 0xf9, 0xb5, 0x96, 0xa9, 0x63, 0x9e, 0x02, 0xf3,
-	0x49, 0xc8, 0x34, 0xa7, 0xff, 0xe2, 0xe1, 0x7b, 0x80, 0xb0, 0x26, 0xb0, 0xbe, 0x67, 0x4c, 0x9c,
-	0xd9, 0xb3, 0x69, 0xc3, 0x9e, 0x69, 0x43, 0xaf, 0x01, 0xe5, 0x2f, 0xe0, 0x79, 0x47, 0x41, 0xdd,
-	0xc2, 0x3b, 0xb0, 0x16, 0x14, 0xe6, 0x24, 0x71, 0x0f, 0xcc, 0x8f, 0x74, 0xaf, 0xcb, 0x16, 0x9f,
-	0x38, 0x86, 0xed, 0xcf, 0x41, 0xbc, 0x26, 0x55, 0xcc, 0xf6, 0xcb, 0x80, 0xff, 0xea, 0x83, 0x55,
-	0x0a, 0x15, 0x80, 0x65, 0x24, 0x63, 0xd2, 0xa4, 0x32, 0xd0, 0xee, 0xf5, 0x2b, 0xf7, 0x8a, 0xa6,
-	0xce, 0xbe, 0x06, 0x49, 0x42, 0xb1, 0x60, 0xa6, 0x67, 0x16, 0x4d, 0x55, 0x31, 0x72, 0x18, 0x2e,
-	0xe9, 0x4e, 0x2e, 0x69, 0x95, 0xc5, 0x81, 0x24, 0xb6, 0xa5, 0x58, 0xad, 0x33, 0x3c, 0x00, 0xcb,
-	0xa7, 0x5b, 0xba, 0xcb, 0xd8, 0xb6, 0xca, 0xea, 0x08, 0x3d, 0x70, 0xae, 0xfc, 0xcb, 0x9a, 0x6a,
-	0xa9, 0x64, 0xf3, 0xa8, 0x50, 0x3f, 0x4d, 0xaf, 0xef, 0x6b, 0xc8, 0x4e, 0xa9, 0xde, 0x3c, 0x43,
-	0x06, 0x3b, 0xaa, 0x9b, 0x7c, 0xc5, 0x06, 0x9e, 0x31, 0x19, 0xf8, 0x55, 0x58, 0x64, 0x4e, 0x42,
-	0x19, 0xa5, 0x89, 0x60, 0xb6, 0xba, 0x76, 0x15, 0xe2, 0x5b, 0xd8, 0x29, 0x4d, 0x13, 0x0c, 0x3c,
-	0x73, 0xe2, 0xcc, 0x46, 0xad, 0x77, 0x28, 0x73, 0x7e, 0x85, 0xe1, 0x1f, 0x00, 0x36, 0xce, 0xe3,
-	0x1b, 0xb0, 0xca, 0xc7, 0x61, 0x46, 0x07, 0x57, 0x4f, 0x98, 0x86, 0xf0, 0xc3, 0x8d, 0x77, 0x88,
-	0xb0, 0x15, 0x17, 0x4f, 0x6f, 0xa8, 0xcb, 0xa8, 0x6f, 0x3e, 0x06, 0x2c, 0xa6, 0x5d, 0x43, 0xf4,
-	0x18, 0xcd, 0x7e, 0x9a, 0xb0, 0x5b, 0x0a, 0x2d, 0x28, 0xff, 0x1e, 0x85, 0x84, 0x9f, 0x60, 0xb7,
-	0xb5, 0x15, 0x78, 0xd4, 0xaa, 0xda, 0xb5, 0x31, 0xee, 0x9f, 0x86, 0x8b, 0xf7, 0xf0, 0x04, 0xec,
-	0x9a, 0x82, 0x2f, 0xbb, 0xa5, 0x2a, 0x99, 0xae, 0xfe, 0x78, 0x0f, 0x4f, 0xc1, 0xae, 0xf7, 0x11,
-	0xbb, 0x30, 0xee, 0xe1, 0x03, 0x53, 0x1f, 0x2e, 0x6f, 0x0f, 0xaf, 0x60, 0xd8, 0xdc, 0x4b, 0xf4,
-	0x5a, 0x8c, 0x8e, 0xcd, 0x76, 0x8f, 0xfe, 0x82, 0xa8, 0x65, 0xcf, 0xc1, 0x69, 0x98, 0x8a, 0xaf,
-	0x1e, 0xf5, 0xd7, 0xb6, 0xdb, 0xdd, 0x6f, 0xdf, 0x5e, 0x67, 0x79, 0xef, 0x8b, 0xa5, 0xfe, 0x61,
-	0xc7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x32, 0x48, 0xf5, 0xd3, 0x04, 0x00, 0x00,
+	// 1565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xdb, 0x72, 0xdb, 0x36,
+	0x13, 0xb6, 0x24, 0x9f, 0xb4, 0xb2, 0x1d, 0x1b, 0x76, 0x1c, 0x44, 0x49, 0x1c, 0x85, 0x39, 0x79,
+	0xfe, 0x43, 0xfe, 0x7f, 0x9c, 0x99, 0x76, 0x7a, 0x29, 0x5b, 0xa9, 0xe3, 0xd6, 0x4e, 0x32, 0x94,
+	0xdd, 0xeb, 0x32, 0xd2, 0xda, 0xe6, 0x84, 0x22, 0x59, 0x02, 0x4a, 0xac, 0xde, 0xf7, 0x35, 0x7a,
+	0xd7, 0xe9, 0xf4, 0x2d, 0x7a, 0xd9, 0xc7, 0xea, 0x2c, 0x0e, 0x24, 0x28, 0xca, 0x89, 0x7b, 0x87,
+	0xfd, 0xb0, 0x58, 0x2c, 0x76, 0x3f, 0x2e, 0xb0, 0x84, 0x95, 0x41, 0x12, 0x9f, 0x87, 0x17, 0x2f,
+	0xd2, 0x2c, 0x91, 0x09, 0x6b, 0x9d, 0x87, 0x19, 0x0a, 0x19, 0x64, 0x12, 0x33, 0xcf, 0x83, 0xf5,
+	0x43, 0x94, 0x07, 0x6a, 0xde, 0xc7, 0x9f, 0xc6, 0x28, 0x24, 0x5b, 0x83, 0xfa, 0x51, 0x8f, 0xd7,
+	0x3a, 0xb5, 0xdd, 0xa6, 0x5f, 0x3f, 0xea, 0x79, 0xdb, 0xb0, 0x95, 0xeb, 0x1c, 0x87, 0x42, 0x1a,
+	0x3d, 0x6f, 0x13, 0x36, 0xfa, 0xc5, 0x5a, 0x91, 0x26, 0xb1, 0x40, 0xef, 0x29, 0x6c, 0xf6, 0x30,
+	0x42, 0x89, 0x5f, 0xb4, 0x59, 0x56, 0x33, 0xcb, 0x5f, 0xc2, 0xed, 0xde, 0x78, 0x94, 0x56, 0x36,
+	0x63, 0x6d, 0x58, 0x4e, 0x03, 0x21, 0x3e, 0x25, 0xd9, 0xd0, 0x98, 0xc9, 0x65, 0xef, 0x97, 0x1a,
+	0x70, 0x1f, 0x85, 0x4c, 0x32, 0xfc, 0x47, 0x0b, 0xd9, 0xd7, 0x00, 0x83, 0x7c, 0x01, 0xaf, 0x77,
+	0x6a, 0xbb, 0xad, 0xbd, 0x3b, 0x2f, 0x9c, 0xf8, 0xbc, 0x70, 0xec, 0x39, 0xaa, 0x6c, 0x0b, 0x16,
+	0x46, 0x98, 0x5d, 0x20, 0x6f, 0x74, 0x6a, 0xbb, 0xcb, 0xbe, 0x16, 0xbc, 0x7b, 0x70, 0x77, 0x86,
+	0x1b, 0xe6, 0x64, 0x3f, 0xc2, 0x36, 0xc9, 0xdd, 0xf1, 0x30, 0x94, 0xaf, 0x3e, 0x62, 0x2c, 0x85,
+	0xe3, 0xa1, 0xd6, 0xcf, 0x23, 0x94, 0xcb, 0xb4, 0x51, 0x3f, 0x8c, 0x07, 0xa8, 0x9c, 0x6b, 0xf8,
+	0x5a, 0x20, 0xf4, 0x2c, 0x96, 0x61, 0xa4, 0xb6, 0x6f, 0xf8, 0x5a, 0xf0, 0xfe, 0xac, 0x01, 0x14,
+	0xe6, 0xa7, 0x43, 0xce, 0x18, 0xcc, 0x9f, 0x86, 0x23, 0x6b, 0x49, 0x8d, 0xc9, 0x50, 0x77, 0x20,
+	0x93, 0x4c, 0x19, 0x6a, 0xfa, 0x5a, 0x60, 0xdb, 0xb0, 0xd8, 0x1d, 0xc8, 0x30, 0x89, 0xf9, 0xbc,
+	0x82, 0x8d, 0x54, 0x72, 0x74, 0x61, 0xca, 0x51, 0x06, 0xf3, 0xbd, 0xf0, 0xfc, 0x9c, 0x2f, 0x76,
+	0x1a, 0xbb, 0x4d, 0x5f, 0x8d, 0xc9, 0x4e, 0x0f, 0x65, 0x10, 0x46, 0x7c, 0x49, 0xdb, 0xd1, 0x12,
+	0xe3, 0xb0, 0xf4, 0x76, 0x2c, 0x07, 0xc9, 0x08, 0xf9, 0xb2, 0x9a, 0xb0, 0xa2, 0xd7, 0x85, 0xb5,
+	0xe2, 0x04, 0x2a, 0xd2, 0xff, 0x83, 0x45, 0x25, 0x08, 0x5e, 0xeb, 0x34, 0x2a, 0xe9, 0x29, 0x94,
+	0x7d, 0xa3, 0xe6, 0x45, 0x8a, 0xad, 0xaf, 0xae, 0x70, 0x30, 0x26, 0xa7, 0x6f, 0x14, 0xe5, 0x36,
+	0x2c, 0xbf, 0x0b, 0x2e, 0xb0, 0x1f, 0xfe, 0xac, 0xc3, 0xb3, 0xe0, 0xe7, 0x32, 0xbb, 0x0f, 0x4d,
+	0x1a, 0x9f, 0x26, 0x1f, 0x30, 0x36, 0x61, 0x2a, 0x00, 0xa2, 0xbb, 0xbb, 0xdb, 0x75, 0x74, 0xff,
+	0xa3, 0x0e, 0xcd, 0x5c, 0xe9, 0x46, 0x99, 0xb9, 0x0f, 0xcd, 0x3e, 0x0a, 0x11, 0x26, 0xf1, 0x51,
+	0xcf, 0x6e, 0x9b, 0x03, 0xa5, 0xc3, 0xcc, 0x57, 0x33, 0x71, 0x26, 0x30, 0x33, 0x19, 0x52, 0x63,
+	0x8a, 0xf8, 0xc1, 0x65, 0x10, 0xc7, 0x18, 0xf1, 0x45, 0x1d, 0x71, 0x23, 0x52, 0x8e, 0x4e, 0x50,
+	0x5e, 0x26, 0x43, 0x9b, 0x23, 0x2d, 0xb1, 0x75, 0x68, 0x9c, 0xf9, 0xc7, 0x26, 0x3f, 0x34, 0x24,
+	0xbb, 0xfb, 0xc9, 0x70, 0xc2, 0x9b, 0xda, 0x2e, 0x8d, 0xd9, 0x0e, 0x40, 0x5f, 0x06, 0x72, 0x2c,
+	0x0e, 0x92, 0x21, 0x72, 0x50, 0xa1, 0x73, 0x10, 0x3a, 0xc5, 0x71, 0x20, 0x31, 0x1e, 0x4c, 0x4e,
+	0x04, 0x6f, 0xa9, 0xe3, 0x15, 0x00, 0xb1, 0xef, 0x55, 0x96, 0x25, 0x19, 0x5f, 0xd1, 0xec, 0x53,
+	0x82, 0x37, 0x82, 0xd5, 0x3c, 0x54, 0x8a, 0x02, 0x5f, 0x01, 0xe4, 0x80, 0xa5, 0xc1, 0x76, 0x89,
+	0x06, 0x45, 0xfc, 0x1d, 0x4d, 0xf6, 0x04, 0x56, 0xdf, 0xe0, 0x95, 0x2c, 0xb2, 0x57, 0x57, 0xdb,
+	0x94, 0x41, 0xef, 0xd7, 0x1a, 0x6c, 0x9c, 0xa2, 0x98, 0xaa, 0x81, 0xff, 0x86, 0x45, 0x0d, 0xa8,
+	0x34, 0xb5, 0xf6, 0x36, 0x67, 0x54, 0x05, 0xdf, 0xa8, 0x50, 0x74, 0x4f, 0x50, 0x88, 0xe0, 0x02,
+	0xcd, 0x16, 0x56, 0x74, 0xe3, 0xde, 0x28, 0xc7, 0x7d, 0x0b, 0x16, 0x7e, 0x08, 0xa2, 0x31, 0x9a,
+	0xf4, 0x69, 0x81, 0x62, 0xdc, 0xc7, 0x78, 0xa8, 0x72, 0xb7, 0xec, 0xab, 0xb1, 0xf7, 0x5b, 0x1d,
+	0x98, 0xeb, 0xa0, 0xae, 0x27, 0x6a, 0xd3, 0x40, 0x0e, 0x2e, 0x51, 0x97, 0xb5, 0x65, 0xdf, 0x8a,
+	0x94, 0xd2, 0xc3, 0x2c, 0x19, 0xa7, 0x82, 0xd7, 0xd5, 0xc7, 0x68, 0x24, 0x45, 0x33, 0xbc, 0x92,
+	0xc6, 0x13, 0x35, 0xb6, 0x69, 0x9e, 0xaf, 0xa6, 0x79, 0xc1, 0x49, 0xf3, 0x7f, 0x61, 0xe9, 0x35,
+	0x06, 0x43, 0xcc, 0x84, 0xfa, 0xbe, 0xa7, 0xc3, 0xa1, 0xe7, 0x7c, 0xab, 0x33, 0xc5, 0x8a, 0xa5,
+	0x0a, 0x2b, 0x3c, 0x58, 0xb1, 0xc7, 0x50, 0x5b, 0x69, 0x92, 0x95, 0x30, 0x62, 0xb8, 0x95, 0x0d,
+	0xe3, 0x72, 0xb9, 0xe0, 0x0d, 0xb8, 0xbc, 0xf9, 0x3f, 0x2c, 0xf6, 0x71, 0x90, 0xa1, 0x3a, 0xd4,
+	0xf7, 0x38, 0x31, 0x1f, 0x18, 0x0d, 0x8b, 0x68, 0xd7, 0x9d, 0x68, 0xd3, 0x0a, 0xed, 0xf2, 0x8d,
+	0x57, 0xbc, 0x86, 0xf9, 0xbe, 0xc4, 0x94, 0x82, 0xf4, 0x26, 0x18, 0xa1, 0x59, 0xa0, 0xc6, 0x79,
+	0x78, 0xeb, 0x4e, 0x78, 0xa9, 0xd2, 0xa5, 0x9a, 0xb7, 0x0d, 0x95, 0x0b, 0x2b, 0x7a, 0xbf, 0x83,
+	0x65, 0x18, 0x6d, 0x75, 0x1a, 0xca, 0xc8, 0x5a, 0xd3, 0x82, 0x29, 0x12, 0xf5, 0xbc, 0x48, 0xd0,
+	0x27, 0xaf, 0xb9, 0x63, 0x6d, 0xe5, 0x32, 0x05, 0x94, 0xb6, 0x3b, 0xc5, 0x51, 0x1a, 0x05, 0xd2,
+	0x72, 0xaa, 0x84, 0x11, 0x2b, 0x7c, 0xbc, 0xc0, 0xab, 0xd4, 0x64, 0xd6, 0x48, 0xac, 0x03, 0xad,
+	0x33, 0xff, 0x38, 0x5f, 0xaa, 0xcb, 0x83, 0x0b, 0x91, 0x75, 0x4a, 0x49, 0xae, 0xa2, 0x0b, 0x45,
+	0x09, 0x53, 0x44, 0xa7, 0xd3, 0x64, 0x23, 0x95, 0xcd, 0x65, 0xdf, 0x8a, 0x34, 0xa3, 0xaf, 0x0f,
+	0xc1, 0x9b, 0x3a, 0x04, 0x46, 0x24, 0x56, 0xe9, 0x84, 0x09, 0x0e, 0x33, 0x58, 0xa5, 0xe7, 0x7c,
+	0xab, 0xe3, 0x54, 0xaa, 0x56, 0xa9, 0x52, 0x75, 0xa0, 0x75, 0x90, 0xc4, 0x12, 0x63, 0x79, 0x3a,
+	0x49, 0xd1, 0xd4, 0x12, 0x17, 0x72, 0xe9, 0xbb, 0x7a, 0x03, 0xfa, 0xfe, 0x0b, 0xd6, 0x2d, 0xd5,
+	0xf2, 0x33, 0xaf, 0x29, 0xab, 0x15, 0x5c, 0xeb, 0xca, 0x6c, 0x72, 0x12, 0x5c, 0x75, 0xa5, 0xc4,
+	0x51, 0x2a, 0x05, 0xbf, 0xa5, 0x08, 0x5f, 0xc1, 0x35, 0xed, 0x65, 0x36, 0xd9, 0x0f, 0x06, 0x1f,
+	0x92, 0xf3, 0x73, 0xbe, 0x6e, 0x69, 0x5f, 0x60, 0xb9, 0xbd, 0xe2, 0x6b, 0x11, 0x7c, 0xa3, 0xd3,
+	0xc8, 0xed, 0x39, 0x38, 0x45, 0x96, 0xae, 0x8a, 0x64, 0x2c, 0x39, 0xd3, 0xc5, 0xc5, 0x88, 0x9a,
+	0x8a, 0xc1, 0x88, 0x6f, 0x5a, 0x2a, 0x06, 0x23, 0x9d, 0xa1, 0xd1, 0x28, 0x88, 0x87, 0x7c, 0xcb,
+	0x94, 0x22, 0x2d, 0xea, 0x4f, 0x2d, 0xd0, 0x17, 0xfe, 0x6d, 0xfb, 0xa9, 0x05, 0xf9, 0x95, 0xdf,
+	0xa7, 0xa2, 0x32, 0x8e, 0x90, 0x6f, 0xeb, 0x39, 0x2b, 0xb3, 0xe7, 0xb0, 0x40, 0x1f, 0x83, 0xe0,
+	0x77, 0x54, 0x50, 0x37, 0xca, 0xd9, 0x93, 0x98, 0xfa, 0x7a, 0x9e, 0xea, 0x81, 0xa1, 0x3d, 0xd5,
+	0x1a, 0xae, 0xcc, 0x38, 0x08, 0xdd, 0x12, 0xdd, 0x34, 0xcd, 0x92, 0x8f, 0x94, 0xa1, 0xbb, 0x8a,
+	0x24, 0x05, 0xc0, 0xfe, 0x03, 0x1b, 0x54, 0x95, 0xc3, 0x0c, 0x87, 0x1a, 0x0c, 0x22, 0xc1, 0xdb,
+	0x2a, 0xc6, 0xd5, 0x09, 0x0a, 0x60, 0x0f, 0xe3, 0x49, 0x1f, 0xa3, 0x73, 0x0b, 0xf2, 0x7b, 0x8a,
+	0x91, 0x15, 0x9c, 0x12, 0xd2, 0x8d, 0xa2, 0xe4, 0x13, 0x0e, 0xe9, 0x92, 0x14, 0xfc, 0xbe, 0xda,
+	0xba, 0x84, 0x11, 0xbb, 0x7a, 0x18, 0x87, 0x56, 0xe5, 0x81, 0x52, 0x71, 0x21, 0xf5, 0x78, 0xc8,
+	0xc2, 0x24, 0x0b, 0xe5, 0x84, 0xef, 0x98, 0xc7, 0x83, 0x91, 0xe9, 0x64, 0xaa, 0x2a, 0x9f, 0x50,
+	0x21, 0x7c, 0xa8, 0x6f, 0xf1, 0x1c, 0xa0, 0x0b, 0xca, 0xc7, 0x34, 0x9a, 0x1c, 0xc5, 0xa7, 0x97,
+	0x19, 0x06, 0x43, 0xde, 0x51, 0x8e, 0x96, 0x41, 0xf2, 0x40, 0x8f, 0xd4, 0x42, 0xfe, 0x48, 0xf3,
+	0xdb, 0x81, 0xd4, 0x5b, 0x41, 0xf1, 0x82, 0xc2, 0xeb, 0x99, 0xb7, 0x82, 0x05, 0xd8, 0x33, 0x58,
+	0xd3, 0xc2, 0x51, 0x2c, 0x31, 0xa3, 0x78, 0x3c, 0x56, 0x2a, 0x53, 0x28, 0x79, 0xa3, 0x11, 0x4b,
+	0xaa, 0x27, 0xfa, 0xba, 0x2c, 0x81, 0x85, 0x56, 0x7f, 0x3c, 0x18, 0xa0, 0x10, 0xfc, 0xa9, 0xab,
+	0x65, 0xc0, 0x42, 0xeb, 0xdb, 0x20, 0x8c, 0xc6, 0x19, 0xf2, 0x67, 0xae, 0x96, 0x01, 0x0b, 0xad,
+	0x77, 0x18, 0x0f, 0xc3, 0xf8, 0x82, 0x3f, 0x77, 0xb5, 0x0c, 0xe8, 0x7d, 0x03, 0x50, 0x3c, 0xa7,
+	0xe9, 0x62, 0x1e, 0xd8, 0x8b, 0xb9, 0x71, 0xed, 0xc5, 0xac, 0x55, 0xbc, 0x9d, 0xa2, 0x66, 0xd2,
+	0x37, 0x11, 0xd1, 0x2b, 0xbf, 0xa6, 0x1f, 0xa8, 0x34, 0xf6, 0xb6, 0x80, 0x51, 0x67, 0x63, 0x54,
+	0x6c, 0x5f, 0xb3, 0x4f, 0x85, 0x66, 0xf4, 0x1e, 0xb3, 0x59, 0x0f, 0x35, 0x55, 0xf6, 0xeb, 0x4e,
+	0xd9, 0xdf, 0x82, 0x05, 0x75, 0xbf, 0xda, 0x56, 0x40, 0x09, 0xde, 0x1e, 0x2c, 0x69, 0x1b, 0x82,
+	0x3d, 0x77, 0x36, 0x9e, 0xf6, 0x57, 0xeb, 0x18, 0x6f, 0x36, 0x61, 0xe3, 0x10, 0xa5, 0x59, 0x66,
+	0x9c, 0xd9, 0xfb, 0x6b, 0x09, 0x56, 0xf5, 0xa9, 0xfa, 0x98, 0x7d, 0x0c, 0x07, 0xc8, 0xde, 0xc2,
+	0x5a, 0xb9, 0x45, 0x62, 0x5e, 0xc9, 0xe6, 0xcc, 0xfe, 0xa9, 0x7d, 0x5d, 0x5b, 0xe3, 0xcd, 0xb1,
+	0x21, 0x6c, 0x54, 0xda, 0x16, 0xf6, 0xb4, 0xa4, 0x7f, 0x5d, 0x77, 0xd5, 0x7e, 0xf6, 0x25, 0x35,
+	0xd3, 0xfd, 0xcc, 0xb1, 0x13, 0x58, 0x2d, 0x75, 0x91, 0xec, 0x51, 0x69, 0xe9, 0xac, 0x0e, 0xf3,
+	0x73, 0x4e, 0x77, 0xa1, 0x99, 0x2f, 0x61, 0x0f, 0x66, 0x9b, 0xb2, 0x66, 0x66, 0x71, 0xc4, 0x9b,
+	0x63, 0xfb, 0xf4, 0xc4, 0xb6, 0x26, 0x66, 0xe9, 0xb4, 0x77, 0xa6, 0x2e, 0xa4, 0xe9, 0x66, 0x77,
+	0x8e, 0x9d, 0xc1, 0x8a, 0xdb, 0xc7, 0xb2, 0x4e, 0x39, 0x15, 0xd5, 0x4e, 0xb8, 0xfd, 0xe8, 0x33,
+	0x1a, 0xb9, 0xd9, 0x43, 0x68, 0x39, 0xc4, 0x64, 0x0f, 0x2b, 0xe7, 0x2b, 0x53, 0xb6, 0x7d, 0xbb,
+	0xec, 0xbd, 0x99, 0xf5, 0xe6, 0x58, 0x0f, 0xa0, 0xe0, 0x14, 0xdb, 0x99, 0xb6, 0x53, 0x26, 0x5b,
+	0x7b, 0x6b, 0x06, 0x39, 0x85, 0x3a, 0xe5, 0xad, 0xa9, 0xde, 0x95, 0x3d, 0x2e, 0xa9, 0xce, 0xee,
+	0x6c, 0xdb, 0xf7, 0xae, 0x69, 0xd6, 0x4c, 0x0e, 0xdf, 0x29, 0x4a, 0x38, 0x2f, 0xf6, 0x0a, 0x25,
+	0x2a, 0x6d, 0x5c, 0xbb, 0x3d, 0xfb, 0xe1, 0x6f, 0x2c, 0x7e, 0x07, 0x2b, 0xee, 0xaa, 0xa9, 0x74,
+	0xcc, 0xe8, 0xd4, 0xda, 0xd7, 0x34, 0x12, 0xde, 0x1c, 0x7b, 0x0b, 0x50, 0x3c, 0xbb, 0xa7, 0x42,
+	0x57, 0x69, 0x18, 0xda, 0x0f, 0xaf, 0x9d, 0xb7, 0x49, 0x7d, 0xbf, 0xa8, 0xfe, 0xbf, 0xbc, 0xfc,
+	0x3b, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xe9, 0xdb, 0x1b, 0x8f, 0x11, 0x00, 0x00,
 }
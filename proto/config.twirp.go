@@ -34,8 +34,10 @@ import url "net/url"
 // =======================
 
 type ConfigService interface {
-	// rpc DumpConfigList(DumpConfigListRequest) returns (ConfigList) {}
-	// rpc RestoreConfigList(RestoreConfigListRequest) returns (RestoreConfigListResponse) {}
+	DumpConfigList(context.Context, *DumpConfigListRequest) (*ConfigList, error)
+
+	RestoreConfigList(context.Context, *RestoreConfigListRequest) (*RestoreConfigListResponse, error)
+
 	GetConfigList(context.Context, *GetConfigListRequest) (*ConfigList, error)
 
 	GetConfig(context.Context, *GetConfigRequest) (*Config, error)
@@ -45,6 +47,16 @@ type ConfigService interface {
 	DeleteConfig(context.Context, *DeleteConfigRequest) (*DeleteConfigResponse, error)
 
 	GetChannels(context.Context, *GetChannelsRequest) (*Channels, error)
+
+	GetMembers(context.Context, *GetMembersRequest) (*Members, error)
+
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*AuditEventList, error)
+
+	GetExecutions(context.Context, *GetExecutionsRequest) (*ExecutionList, error)
+
+	GetExecution(context.Context, *GetExecutionRequest) (*Execution, error)
+
+	TestConfig(context.Context, *TestConfigRequest) (*TestConfigResponse, error)
 }
 
 // =============================
@@ -53,19 +65,26 @@ type ConfigService interface {
 
 type configServiceProtobufClient struct {
 	client HTTPClient
-	urls   [5]string
+	urls   [12]string
 }
 
 // NewConfigServiceProtobufClient creates a Protobuf client that implements the ConfigService interface.
 // It communicates using Protobuf and can be configured with a custom HTTPClient.
 func NewConfigServiceProtobufClient(addr string, client HTTPClient) ConfigService {
 	prefix := urlBase(addr) + ConfigServicePathPrefix
-	urls := [5]string{
+	urls := [12]string{
+		prefix + "DumpConfigList",
+		prefix + "RestoreConfigList",
 		prefix + "GetConfigList",
 		prefix + "GetConfig",
 		prefix + "SetConfig",
 		prefix + "DeleteConfig",
 		prefix + "GetChannels",
+		prefix + "GetMembers",
+		prefix + "ListAuditEvents",
+		prefix + "GetExecutions",
+		prefix + "GetExecution",
+		prefix + "TestConfig",
 	}
 	if httpClient, ok := client.(*http.Client); ok {
 		return &configServiceProtobufClient{
@@ -79,12 +98,30 @@ func NewConfigServiceProtobufClient(addr string, client HTTPClient) ConfigServic
 	}
 }
 
+func (c *configServiceProtobufClient) DumpConfigList(ctx context.Context, in *DumpConfigListRequest) (*ConfigList, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "DumpConfigList")
+	out := new(ConfigList)
+	err := doProtobufRequest(ctx, c.client, c.urls[0], in, out)
+	return out, err
+}
+
+func (c *configServiceProtobufClient) RestoreConfigList(ctx context.Context, in *RestoreConfigListRequest) (*RestoreConfigListResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreConfigList")
+	out := new(RestoreConfigListResponse)
+	err := doProtobufRequest(ctx, c.client, c.urls[1], in, out)
+	return out, err
+}
+
 func (c *configServiceProtobufClient) GetConfigList(ctx context.Context, in *GetConfigListRequest) (*ConfigList, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
 	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
 	ctx = ctxsetters.WithMethodName(ctx, "GetConfigList")
 	out := new(ConfigList)
-	err := doProtobufRequest(ctx, c.client, c.urls[0], in, out)
+	err := doProtobufRequest(ctx, c.client, c.urls[2], in, out)
 	return out, err
 }
 
@@ -93,7 +130,7 @@ func (c *configServiceProtobufClient) GetConfig(ctx context.Context, in *GetConf
 	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
 	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
 	out := new(Config)
-	err := doProtobufRequest(ctx, c.client, c.urls[1], in, out)
+	err := doProtobufRequest(ctx, c.client, c.urls[3], in, out)
 	return out, err
 }
 
@@ -102,7 +139,7 @@ func (c *configServiceProtobufClient) SetConfig(ctx context.Context, in *Config)
 	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
 	ctx = ctxsetters.WithMethodName(ctx, "SetConfig")
 	out := new(SetConfigResponse)
-	err := doProtobufRequest(ctx, c.client, c.urls[2], in, out)
+	err := doProtobufRequest(ctx, c.client, c.urls[4], in, out)
 	return out, err
 }
 
@@ -111,7 +148,7 @@ func (c *configServiceProtobufClient) DeleteConfig(ctx context.Context, in *Dele
 	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
 	ctx = ctxsetters.WithMethodName(ctx, "DeleteConfig")
 	out := new(DeleteConfigResponse)
-	err := doProtobufRequest(ctx, c.client, c.urls[3], in, out)
+	err := doProtobufRequest(ctx, c.client, c.urls[5], in, out)
 	return out, err
 }
 
@@ -120,7 +157,52 @@ func (c *configServiceProtobufClient) GetChannels(ctx context.Context, in *GetCh
 	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
 	ctx = ctxsetters.WithMethodName(ctx, "GetChannels")
 	out := new(Channels)
-	err := doProtobufRequest(ctx, c.client, c.urls[4], in, out)
+	err := doProtobufRequest(ctx, c.client, c.urls[6], in, out)
+	return out, err
+}
+
+func (c *configServiceProtobufClient) GetMembers(ctx context.Context, in *GetMembersRequest) (*Members, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMembers")
+	out := new(Members)
+	err := doProtobufRequest(ctx, c.client, c.urls[7], in, out)
+	return out, err
+}
+
+func (c *configServiceProtobufClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest) (*AuditEventList, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListAuditEvents")
+	out := new(AuditEventList)
+	err := doProtobufRequest(ctx, c.client, c.urls[8], in, out)
+	return out, err
+}
+
+func (c *configServiceProtobufClient) GetExecutions(ctx context.Context, in *GetExecutionsRequest) (*ExecutionList, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetExecutions")
+	out := new(ExecutionList)
+	err := doProtobufRequest(ctx, c.client, c.urls[9], in, out)
+	return out, err
+}
+
+func (c *configServiceProtobufClient) GetExecution(ctx context.Context, in *GetExecutionRequest) (*Execution, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetExecution")
+	out := new(Execution)
+	err := doProtobufRequest(ctx, c.client, c.urls[10], in, out)
+	return out, err
+}
+
+func (c *configServiceProtobufClient) TestConfig(ctx context.Context, in *TestConfigRequest) (*TestConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "TestConfig")
+	out := new(TestConfigResponse)
+	err := doProtobufRequest(ctx, c.client, c.urls[11], in, out)
 	return out, err
 }
 
@@ -130,19 +212,26 @@ func (c *configServiceProtobufClient) GetChannels(ctx context.Context, in *GetCh
 
 type configServiceJSONClient struct {
 	client HTTPClient
-	urls   [5]string
+	urls   [12]string
 }
 
 // NewConfigServiceJSONClient creates a JSON client that implements the ConfigService interface.
 // It communicates using JSON and can be configured with a custom HTTPClient.
 func NewConfigServiceJSONClient(addr string, client HTTPClient) ConfigService {
 	prefix := urlBase(addr) + ConfigServicePathPrefix
-	urls := [5]string{
+	urls := [12]string{
+		prefix + "DumpConfigList",
+		prefix + "RestoreConfigList",
 		prefix + "GetConfigList",
 		prefix + "GetConfig",
 		prefix + "SetConfig",
 		prefix + "DeleteConfig",
 		prefix + "GetChannels",
+		prefix + "GetMembers",
+		prefix + "ListAuditEvents",
+		prefix + "GetExecutions",
+		prefix + "GetExecution",
+		prefix + "TestConfig",
 	}
 	if httpClient, ok := client.(*http.Client); ok {
 		return &configServiceJSONClient{
@@ -156,12 +245,30 @@ func NewConfigServiceJSONClient(addr string, client HTTPClient) ConfigService {
 	}
 }
 
+func (c *configServiceJSONClient) DumpConfigList(ctx context.Context, in *DumpConfigListRequest) (*ConfigList, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "DumpConfigList")
+	out := new(ConfigList)
+	err := doJSONRequest(ctx, c.client, c.urls[0], in, out)
+	return out, err
+}
+
+func (c *configServiceJSONClient) RestoreConfigList(ctx context.Context, in *RestoreConfigListRequest) (*RestoreConfigListResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreConfigList")
+	out := new(RestoreConfigListResponse)
+	err := doJSONRequest(ctx, c.client, c.urls[1], in, out)
+	return out, err
+}
+
 func (c *configServiceJSONClient) GetConfigList(ctx context.Context, in *GetConfigListRequest) (*ConfigList, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
 	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
 	ctx = ctxsetters.WithMethodName(ctx, "GetConfigList")
 	out := new(ConfigList)
-	err := doJSONRequest(ctx, c.client, c.urls[0], in, out)
+	err := doJSONRequest(ctx, c.client, c.urls[2], in, out)
 	return out, err
 }
 
@@ -170,7 +277,7 @@ func (c *configServiceJSONClient) GetConfig(ctx context.Context, in *GetConfigRe
 	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
 	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
 	out := new(Config)
-	err := doJSONRequest(ctx, c.client, c.urls[1], in, out)
+	err := doJSONRequest(ctx, c.client, c.urls[3], in, out)
 	return out, err
 }
 
@@ -179,7 +286,7 @@ func (c *configServiceJSONClient) SetConfig(ctx context.Context, in *Config) (*S
 	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
 	ctx = ctxsetters.WithMethodName(ctx, "SetConfig")
 	out := new(SetConfigResponse)
-	err := doJSONRequest(ctx, c.client, c.urls[2], in, out)
+	err := doJSONRequest(ctx, c.client, c.urls[4], in, out)
 	return out, err
 }
 
@@ -188,7 +295,7 @@ func (c *configServiceJSONClient) DeleteConfig(ctx context.Context, in *DeleteCo
 	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
 	ctx = ctxsetters.WithMethodName(ctx, "DeleteConfig")
 	out := new(DeleteConfigResponse)
-	err := doJSONRequest(ctx, c.client, c.urls[3], in, out)
+	err := doJSONRequest(ctx, c.client, c.urls[5], in, out)
 	return out, err
 }
 
@@ -197,7 +304,52 @@ func (c *configServiceJSONClient) GetChannels(ctx context.Context, in *GetChanne
 	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
 	ctx = ctxsetters.WithMethodName(ctx, "GetChannels")
 	out := new(Channels)
-	err := doJSONRequest(ctx, c.client, c.urls[4], in, out)
+	err := doJSONRequest(ctx, c.client, c.urls[6], in, out)
+	return out, err
+}
+
+func (c *configServiceJSONClient) GetMembers(ctx context.Context, in *GetMembersRequest) (*Members, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMembers")
+	out := new(Members)
+	err := doJSONRequest(ctx, c.client, c.urls[7], in, out)
+	return out, err
+}
+
+func (c *configServiceJSONClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest) (*AuditEventList, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListAuditEvents")
+	out := new(AuditEventList)
+	err := doJSONRequest(ctx, c.client, c.urls[8], in, out)
+	return out, err
+}
+
+func (c *configServiceJSONClient) GetExecutions(ctx context.Context, in *GetExecutionsRequest) (*ExecutionList, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetExecutions")
+	out := new(ExecutionList)
+	err := doJSONRequest(ctx, c.client, c.urls[9], in, out)
+	return out, err
+}
+
+func (c *configServiceJSONClient) GetExecution(ctx context.Context, in *GetExecutionRequest) (*Execution, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetExecution")
+	out := new(Execution)
+	err := doJSONRequest(ctx, c.client, c.urls[10], in, out)
+	return out, err
+}
+
+func (c *configServiceJSONClient) TestConfig(ctx context.Context, in *TestConfigRequest) (*TestConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "firestarter")
+	ctx = ctxsetters.WithServiceName(ctx, "ConfigService")
+	ctx = ctxsetters.WithMethodName(ctx, "TestConfig")
+	out := new(TestConfigResponse)
+	err := doJSONRequest(ctx, c.client, c.urls[11], in, out)
 	return out, err
 }
 
@@ -249,6 +401,12 @@ func (s *configServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Requ
 	}
 
 	switch req.URL.Path {
+	case "/twirp/firestarter.ConfigService/DumpConfigList":
+		s.serveDumpConfigList(ctx, resp, req)
+		return
+	case "/twirp/firestarter.ConfigService/RestoreConfigList":
+		s.serveRestoreConfigList(ctx, resp, req)
+		return
 	case "/twirp/firestarter.ConfigService/GetConfigList":
 		s.serveGetConfigList(ctx, resp, req)
 		return
@@ -264,6 +422,21 @@ func (s *configServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Requ
 	case "/twirp/firestarter.ConfigService/GetChannels":
 		s.serveGetChannels(ctx, resp, req)
 		return
+	case "/twirp/firestarter.ConfigService/GetMembers":
+		s.serveGetMembers(ctx, resp, req)
+		return
+	case "/twirp/firestarter.ConfigService/ListAuditEvents":
+		s.serveListAuditEvents(ctx, resp, req)
+		return
+	case "/twirp/firestarter.ConfigService/GetExecutions":
+		s.serveGetExecutions(ctx, resp, req)
+		return
+	case "/twirp/firestarter.ConfigService/GetExecution":
+		s.serveGetExecution(ctx, resp, req)
+		return
+	case "/twirp/firestarter.ConfigService/TestConfig":
+		s.serveTestConfig(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		err = badRouteError(msg, req.Method, req.URL.Path)
@@ -272,7 +445,7 @@ func (s *configServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Requ
 	}
 }
 
-func (s *configServiceServer) serveGetConfigList(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveDumpConfigList(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -280,9 +453,9 @@ func (s *configServiceServer) serveGetConfigList(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetConfigListJSON(ctx, resp, req)
+		s.serveDumpConfigListJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetConfigListProtobuf(ctx, resp, req)
+		s.serveDumpConfigListProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -290,9 +463,9 @@ func (s *configServiceServer) serveGetConfigList(ctx context.Context, resp http.
 	}
 }
 
-func (s *configServiceServer) serveGetConfigListJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveDumpConfigListJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetConfigList")
+	ctx = ctxsetters.WithMethodName(ctx, "DumpConfigList")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -300,7 +473,7 @@ func (s *configServiceServer) serveGetConfigListJSON(ctx context.Context, resp h
 	}
 
 	defer closebody(req.Body)
-	reqContent := new(GetConfigListRequest)
+	reqContent := new(DumpConfigListRequest)
 	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
 	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
 		err = wrapErr(err, "failed to parse request json")
@@ -318,7 +491,7 @@ func (s *configServiceServer) serveGetConfigListJSON(ctx context.Context, resp h
 				panic(r)
 			}
 		}()
-		respContent, err = s.GetConfigList(ctx, reqContent)
+		respContent, err = s.DumpConfigList(ctx, reqContent)
 	}()
 
 	if err != nil {
@@ -326,7 +499,7 @@ func (s *configServiceServer) serveGetConfigListJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfigList and nil error while calling GetConfigList. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfigList and nil error while calling DumpConfigList. nil responses are not supported"))
 		return
 	}
 
@@ -349,9 +522,9 @@ func (s *configServiceServer) serveGetConfigListJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *configServiceServer) serveGetConfigListProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveDumpConfigListProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetConfigList")
+	ctx = ctxsetters.WithMethodName(ctx, "DumpConfigList")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -365,7 +538,7 @@ func (s *configServiceServer) serveGetConfigListProtobuf(ctx context.Context, re
 		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
 		return
 	}
-	reqContent := new(GetConfigListRequest)
+	reqContent := new(DumpConfigListRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		err = wrapErr(err, "failed to parse request proto")
 		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
@@ -382,7 +555,7 @@ func (s *configServiceServer) serveGetConfigListProtobuf(ctx context.Context, re
 				panic(r)
 			}
 		}()
-		respContent, err = s.GetConfigList(ctx, reqContent)
+		respContent, err = s.DumpConfigList(ctx, reqContent)
 	}()
 
 	if err != nil {
@@ -390,7 +563,7 @@ func (s *configServiceServer) serveGetConfigListProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfigList and nil error while calling GetConfigList. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfigList and nil error while calling DumpConfigList. nil responses are not supported"))
 		return
 	}
 
@@ -412,7 +585,7 @@ func (s *configServiceServer) serveGetConfigListProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *configServiceServer) serveGetConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveRestoreConfigList(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -420,9 +593,9 @@ func (s *configServiceServer) serveGetConfig(ctx context.Context, resp http.Resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetConfigJSON(ctx, resp, req)
+		s.serveRestoreConfigListJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetConfigProtobuf(ctx, resp, req)
+		s.serveRestoreConfigListProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -430,9 +603,9 @@ func (s *configServiceServer) serveGetConfig(ctx context.Context, resp http.Resp
 	}
 }
 
-func (s *configServiceServer) serveGetConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveRestoreConfigListJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreConfigList")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -440,7 +613,7 @@ func (s *configServiceServer) serveGetConfigJSON(ctx context.Context, resp http.
 	}
 
 	defer closebody(req.Body)
-	reqContent := new(GetConfigRequest)
+	reqContent := new(RestoreConfigListRequest)
 	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
 	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
 		err = wrapErr(err, "failed to parse request json")
@@ -449,7 +622,7 @@ func (s *configServiceServer) serveGetConfigJSON(ctx context.Context, resp http.
 	}
 
 	// Call service method
-	var respContent *Config
+	var respContent *RestoreConfigListResponse
 	func() {
 		defer func() {
 			// In case of a panic, serve a 500 error and then panic.
@@ -458,7 +631,7 @@ func (s *configServiceServer) serveGetConfigJSON(ctx context.Context, resp http.
 				panic(r)
 			}
 		}()
-		respContent, err = s.GetConfig(ctx, reqContent)
+		respContent, err = s.RestoreConfigList(ctx, reqContent)
 	}()
 
 	if err != nil {
@@ -466,7 +639,7 @@ func (s *configServiceServer) serveGetConfigJSON(ctx context.Context, resp http.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Config and nil error while calling GetConfig. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RestoreConfigListResponse and nil error while calling RestoreConfigList. nil responses are not supported"))
 		return
 	}
 
@@ -489,9 +662,9 @@ func (s *configServiceServer) serveGetConfigJSON(ctx context.Context, resp http.
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *configServiceServer) serveGetConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveRestoreConfigListProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreConfigList")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -505,7 +678,7 @@ func (s *configServiceServer) serveGetConfigProtobuf(ctx context.Context, resp h
 		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
 		return
 	}
-	reqContent := new(GetConfigRequest)
+	reqContent := new(RestoreConfigListRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		err = wrapErr(err, "failed to parse request proto")
 		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
@@ -513,7 +686,7 @@ func (s *configServiceServer) serveGetConfigProtobuf(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *Config
+	var respContent *RestoreConfigListResponse
 	func() {
 		defer func() {
 			// In case of a panic, serve a 500 error and then panic.
@@ -522,7 +695,7 @@ func (s *configServiceServer) serveGetConfigProtobuf(ctx context.Context, resp h
 				panic(r)
 			}
 		}()
-		respContent, err = s.GetConfig(ctx, reqContent)
+		respContent, err = s.RestoreConfigList(ctx, reqContent)
 	}()
 
 	if err != nil {
@@ -530,7 +703,7 @@ func (s *configServiceServer) serveGetConfigProtobuf(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Config and nil error while calling GetConfig. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RestoreConfigListResponse and nil error while calling RestoreConfigList. nil responses are not supported"))
 		return
 	}
 
@@ -552,7 +725,7 @@ func (s *configServiceServer) serveGetConfigProtobuf(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *configServiceServer) serveSetConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveGetConfigList(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -560,9 +733,9 @@ func (s *configServiceServer) serveSetConfig(ctx context.Context, resp http.Resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetConfigJSON(ctx, resp, req)
+		s.serveGetConfigListJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetConfigProtobuf(ctx, resp, req)
+		s.serveGetConfigListProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -570,9 +743,9 @@ func (s *configServiceServer) serveSetConfig(ctx context.Context, resp http.Resp
 	}
 }
 
-func (s *configServiceServer) serveSetConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveGetConfigListJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetConfig")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfigList")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -580,7 +753,7 @@ func (s *configServiceServer) serveSetConfigJSON(ctx context.Context, resp http.
 	}
 
 	defer closebody(req.Body)
-	reqContent := new(Config)
+	reqContent := new(GetConfigListRequest)
 	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
 	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
 		err = wrapErr(err, "failed to parse request json")
@@ -589,7 +762,7 @@ func (s *configServiceServer) serveSetConfigJSON(ctx context.Context, resp http.
 	}
 
 	// Call service method
-	var respContent *SetConfigResponse
+	var respContent *ConfigList
 	func() {
 		defer func() {
 			// In case of a panic, serve a 500 error and then panic.
@@ -598,7 +771,7 @@ func (s *configServiceServer) serveSetConfigJSON(ctx context.Context, resp http.
 				panic(r)
 			}
 		}()
-		respContent, err = s.SetConfig(ctx, reqContent)
+		respContent, err = s.GetConfigList(ctx, reqContent)
 	}()
 
 	if err != nil {
@@ -606,7 +779,7 @@ func (s *configServiceServer) serveSetConfigJSON(ctx context.Context, resp http.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetConfigResponse and nil error while calling SetConfig. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfigList and nil error while calling GetConfigList. nil responses are not supported"))
 		return
 	}
 
@@ -629,9 +802,9 @@ func (s *configServiceServer) serveSetConfigJSON(ctx context.Context, resp http.
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *configServiceServer) serveSetConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveGetConfigListProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetConfig")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfigList")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -645,7 +818,7 @@ func (s *configServiceServer) serveSetConfigProtobuf(ctx context.Context, resp h
 		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
 		return
 	}
-	reqContent := new(Config)
+	reqContent := new(GetConfigListRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		err = wrapErr(err, "failed to parse request proto")
 		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
@@ -653,7 +826,7 @@ func (s *configServiceServer) serveSetConfigProtobuf(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *SetConfigResponse
+	var respContent *ConfigList
 	func() {
 		defer func() {
 			// In case of a panic, serve a 500 error and then panic.
@@ -662,7 +835,7 @@ func (s *configServiceServer) serveSetConfigProtobuf(ctx context.Context, resp h
 				panic(r)
 			}
 		}()
-		respContent, err = s.SetConfig(ctx, reqContent)
+		respContent, err = s.GetConfigList(ctx, reqContent)
 	}()
 
 	if err != nil {
@@ -670,7 +843,7 @@ func (s *configServiceServer) serveSetConfigProtobuf(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetConfigResponse and nil error while calling SetConfig. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfigList and nil error while calling GetConfigList. nil responses are not supported"))
 		return
 	}
 
@@ -692,7 +865,7 @@ func (s *configServiceServer) serveSetConfigProtobuf(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *configServiceServer) serveDeleteConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveGetConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -700,9 +873,9 @@ func (s *configServiceServer) serveDeleteConfig(ctx context.Context, resp http.R
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveDeleteConfigJSON(ctx, resp, req)
+		s.serveGetConfigJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveDeleteConfigProtobuf(ctx, resp, req)
+		s.serveGetConfigProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -710,9 +883,9 @@ func (s *configServiceServer) serveDeleteConfig(ctx context.Context, resp http.R
 	}
 }
 
-func (s *configServiceServer) serveDeleteConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveGetConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteConfig")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -720,7 +893,7 @@ func (s *configServiceServer) serveDeleteConfigJSON(ctx context.Context, resp ht
 	}
 
 	defer closebody(req.Body)
-	reqContent := new(DeleteConfigRequest)
+	reqContent := new(GetConfigRequest)
 	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
 	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
 		err = wrapErr(err, "failed to parse request json")
@@ -729,7 +902,7 @@ func (s *configServiceServer) serveDeleteConfigJSON(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *DeleteConfigResponse
+	var respContent *Config
 	func() {
 		defer func() {
 			// In case of a panic, serve a 500 error and then panic.
@@ -738,7 +911,7 @@ func (s *configServiceServer) serveDeleteConfigJSON(ctx context.Context, resp ht
 				panic(r)
 			}
 		}()
-		respContent, err = s.DeleteConfig(ctx, reqContent)
+		respContent, err = s.GetConfig(ctx, reqContent)
 	}()
 
 	if err != nil {
@@ -746,7 +919,7 @@ func (s *configServiceServer) serveDeleteConfigJSON(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteConfigResponse and nil error while calling DeleteConfig. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Config and nil error while calling GetConfig. nil responses are not supported"))
 		return
 	}
 
@@ -769,9 +942,9 @@ func (s *configServiceServer) serveDeleteConfigJSON(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *configServiceServer) serveDeleteConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveGetConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteConfig")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -785,7 +958,7 @@ func (s *configServiceServer) serveDeleteConfigProtobuf(ctx context.Context, res
 		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
 		return
 	}
-	reqContent := new(DeleteConfigRequest)
+	reqContent := new(GetConfigRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		err = wrapErr(err, "failed to parse request proto")
 		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
@@ -793,7 +966,7 @@ func (s *configServiceServer) serveDeleteConfigProtobuf(ctx context.Context, res
 	}
 
 	// Call service method
-	var respContent *DeleteConfigResponse
+	var respContent *Config
 	func() {
 		defer func() {
 			// In case of a panic, serve a 500 error and then panic.
@@ -802,7 +975,7 @@ func (s *configServiceServer) serveDeleteConfigProtobuf(ctx context.Context, res
 				panic(r)
 			}
 		}()
-		respContent, err = s.DeleteConfig(ctx, reqContent)
+		respContent, err = s.GetConfig(ctx, reqContent)
 	}()
 
 	if err != nil {
@@ -810,7 +983,7 @@ func (s *configServiceServer) serveDeleteConfigProtobuf(ctx context.Context, res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteConfigResponse and nil error while calling DeleteConfig. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Config and nil error while calling GetConfig. nil responses are not supported"))
 		return
 	}
 
@@ -832,7 +1005,7 @@ func (s *configServiceServer) serveDeleteConfigProtobuf(ctx context.Context, res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *configServiceServer) serveGetChannels(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveSetConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -840,9 +1013,9 @@ func (s *configServiceServer) serveGetChannels(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetChannelsJSON(ctx, resp, req)
+		s.serveSetConfigJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetChannelsProtobuf(ctx, resp, req)
+		s.serveSetConfigProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -850,9 +1023,9 @@ func (s *configServiceServer) serveGetChannels(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *configServiceServer) serveGetChannelsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveSetConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetChannels")
+	ctx = ctxsetters.WithMethodName(ctx, "SetConfig")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -860,7 +1033,7 @@ func (s *configServiceServer) serveGetChannelsJSON(ctx context.Context, resp htt
 	}
 
 	defer closebody(req.Body)
-	reqContent := new(GetChannelsRequest)
+	reqContent := new(Config)
 	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
 	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
 		err = wrapErr(err, "failed to parse request json")
@@ -869,7 +1042,7 @@ func (s *configServiceServer) serveGetChannelsJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *Channels
+	var respContent *SetConfigResponse
 	func() {
 		defer func() {
 			// In case of a panic, serve a 500 error and then panic.
@@ -878,7 +1051,7 @@ func (s *configServiceServer) serveGetChannelsJSON(ctx context.Context, resp htt
 				panic(r)
 			}
 		}()
-		respContent, err = s.GetChannels(ctx, reqContent)
+		respContent, err = s.SetConfig(ctx, reqContent)
 	}()
 
 	if err != nil {
@@ -886,7 +1059,7 @@ func (s *configServiceServer) serveGetChannelsJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Channels and nil error while calling GetChannels. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetConfigResponse and nil error while calling SetConfig. nil responses are not supported"))
 		return
 	}
 
@@ -909,9 +1082,9 @@ func (s *configServiceServer) serveGetChannelsJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *configServiceServer) serveGetChannelsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServiceServer) serveSetConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetChannels")
+	ctx = ctxsetters.WithMethodName(ctx, "SetConfig")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -925,7 +1098,7 @@ func (s *configServiceServer) serveGetChannelsProtobuf(ctx context.Context, resp
 		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
 		return
 	}
-	reqContent := new(GetChannelsRequest)
+	reqContent := new(Config)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		err = wrapErr(err, "failed to parse request proto")
 		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
@@ -933,7 +1106,7 @@ func (s *configServiceServer) serveGetChannelsProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *Channels
+	var respContent *SetConfigResponse
 	func() {
 		defer func() {
 			// In case of a panic, serve a 500 error and then panic.
@@ -942,7 +1115,7 @@ func (s *configServiceServer) serveGetChannelsProtobuf(ctx context.Context, resp
 				panic(r)
 			}
 		}()
-		respContent, err = s.GetChannels(ctx, reqContent)
+		respContent, err = s.SetConfig(ctx, reqContent)
 	}()
 
 	if err != nil {
@@ -950,7 +1123,987 @@ func (s *configServiceServer) serveGetChannelsProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Channels and nil error while calling GetChannels. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetConfigResponse and nil error while calling SetConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		err = wrapErr(err, "failed to marshal proto response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(respBytes); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveDeleteConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteConfigJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteConfigProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *configServiceServer) serveDeleteConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	reqContent := new(DeleteConfigRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request json")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *DeleteConfigResponse
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.DeleteConfig(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteConfigResponse and nil error while calling DeleteConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		err = wrapErr(err, "failed to marshal json response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(buf.Bytes()); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveDeleteConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		err = wrapErr(err, "failed to read request body")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+	reqContent := new(DeleteConfigRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request proto")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *DeleteConfigResponse
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.DeleteConfig(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteConfigResponse and nil error while calling DeleteConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		err = wrapErr(err, "failed to marshal proto response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(respBytes); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveGetChannels(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetChannelsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetChannelsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *configServiceServer) serveGetChannelsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetChannels")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	reqContent := new(GetChannelsRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request json")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *Channels
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.GetChannels(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Channels and nil error while calling GetChannels. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		err = wrapErr(err, "failed to marshal json response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(buf.Bytes()); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveGetChannelsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetChannels")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		err = wrapErr(err, "failed to read request body")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+	reqContent := new(GetChannelsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request proto")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *Channels
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.GetChannels(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Channels and nil error while calling GetChannels. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		err = wrapErr(err, "failed to marshal proto response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(respBytes); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveGetMembers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetMembersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetMembersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *configServiceServer) serveGetMembersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMembers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	reqContent := new(GetMembersRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request json")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *Members
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.GetMembers(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Members and nil error while calling GetMembers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		err = wrapErr(err, "failed to marshal json response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(buf.Bytes()); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveGetMembersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMembers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		err = wrapErr(err, "failed to read request body")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+	reqContent := new(GetMembersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request proto")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *Members
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.GetMembers(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Members and nil error while calling GetMembers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		err = wrapErr(err, "failed to marshal proto response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(respBytes); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveListAuditEvents(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListAuditEventsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListAuditEventsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *configServiceServer) serveListAuditEventsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAuditEvents")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	reqContent := new(ListAuditEventsRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request json")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *AuditEventList
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.ListAuditEvents(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuditEventList and nil error while calling ListAuditEvents. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		err = wrapErr(err, "failed to marshal json response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(buf.Bytes()); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveListAuditEventsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAuditEvents")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		err = wrapErr(err, "failed to read request body")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+	reqContent := new(ListAuditEventsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request proto")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *AuditEventList
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.ListAuditEvents(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuditEventList and nil error while calling ListAuditEvents. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		err = wrapErr(err, "failed to marshal proto response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(respBytes); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveGetExecutions(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetExecutionsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetExecutionsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *configServiceServer) serveGetExecutionsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetExecutions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	reqContent := new(GetExecutionsRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request json")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *ExecutionList
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.GetExecutions(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExecutionList and nil error while calling GetExecutions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		err = wrapErr(err, "failed to marshal json response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(buf.Bytes()); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveGetExecutionsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetExecutions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		err = wrapErr(err, "failed to read request body")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+	reqContent := new(GetExecutionsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request proto")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *ExecutionList
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.GetExecutions(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExecutionList and nil error while calling GetExecutions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		err = wrapErr(err, "failed to marshal proto response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(respBytes); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveGetExecution(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetExecutionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetExecutionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *configServiceServer) serveGetExecutionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetExecution")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	reqContent := new(GetExecutionRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request json")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *Execution
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.GetExecution(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Execution and nil error while calling GetExecution. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		err = wrapErr(err, "failed to marshal json response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(buf.Bytes()); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveGetExecutionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetExecution")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		err = wrapErr(err, "failed to read request body")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+	reqContent := new(GetExecutionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request proto")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *Execution
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.GetExecution(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Execution and nil error while calling GetExecution. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		err = wrapErr(err, "failed to marshal proto response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(respBytes); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveTestConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveTestConfigJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveTestConfigProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *configServiceServer) serveTestConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "TestConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	reqContent := new(TestConfigRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request json")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *TestConfigResponse
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.TestConfig(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TestConfigResponse and nil error while calling TestConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		err = wrapErr(err, "failed to marshal json response")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err = resp.Write(buf.Bytes()); err != nil {
+		log.Printf("errored while writing response to client, but already sent response status code to 200: %s", err)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *configServiceServer) serveTestConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "TestConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	defer closebody(req.Body)
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		err = wrapErr(err, "failed to read request body")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+	reqContent := new(TestConfigRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		err = wrapErr(err, "failed to parse request proto")
+		s.writeError(ctx, resp, twirp.InternalErrorWith(err))
+		return
+	}
+
+	// Call service method
+	var respContent *TestConfigResponse
+	func() {
+		defer func() {
+			// In case of a panic, serve a 500 error and then panic.
+			if r := recover(); r != nil {
+				s.writeError(ctx, resp, twirp.InternalError("Internal service panic"))
+				panic(r)
+			}
+		}()
+		respContent, err = s.TestConfig(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *TestConfigResponse and nil error while calling TestConfig. nil responses are not supported"))
 		return
 	}
 
@@ -1395,37 +2548,103 @@ func callError(ctx context.Context, h *twirp.ServerHooks, err twirp.Error) conte
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0xe3, 0xd6, 0x8d, 0xc7, 0x29, 0x2a, 0x93, 0xb4, 0x2c, 0x46, 0x14, 0x77, 0x25, 0xa4,
-	0x48, 0x88, 0x08, 0xa5, 0x07, 0xc4, 0xb1, 0x6d, 0xa4, 0xaa, 0xa2, 0x5c, 0x9c, 0x94, 0xbb, 0x71,
-	0xa7, 0xc5, 0x92, 0x63, 0x1b, 0xef, 0x06, 0xda, 0x0f, 0xe0, 0x7b, 0xf8, 0x45, 0xe4, 0xf5, 0xda,
-	0xb1, 0x5b, 0x03, 0xe2, 0xe6, 0xd9, 0x79, 0xef, 0xcd, 0xce, 0xdb, 0x19, 0xc3, 0x30, 0x4c, 0x93,
-	0x9b, 0xe8, 0x76, 0x9a, 0xe5, 0xa9, 0x4c, 0xd1, 0xb9, 0x89, 0x72, 0x12, 0x32, 0xc8, 0x25, 0xe5,
-	0x9c, 0xc3, 0xde, 0x39, 0xc9, 0x33, 0x95, 0xf7, 0xe9, 0xdb, 0x9a, 0x84, 0xc4, 0x27, 0xd0, 0xbf,
-	0x98, 0x33, 0xc3, 0x33, 0x26, 0xb6, 0xdf, 0xbf, 0x98, 0xf3, 0x03, 0x18, 0xd7, 0x98, 0xcb, 0x48,
-	0x48, 0x8d, 0xe3, 0x23, 0x78, 0xba, 0xd8, 0x70, 0x45, 0x96, 0x26, 0x82, 0xf8, 0x6b, 0x18, 0xcd,
-	0x29, 0x26, 0x49, 0xff, 0xd4, 0x6c, 0xc3, 0x34, 0xfd, 0x18, 0xf6, 0xe7, 0xeb, 0x55, 0xf6, 0xa8,
-	0x18, 0xba, 0x30, 0xc8, 0x02, 0x21, 0x7e, 0xa4, 0xf9, 0xb5, 0x96, 0xa9, 0x63, 0x9e, 0x02, 0xf3,
-	0x49, 0xc8, 0x34, 0xa7, 0xff, 0xe2, 0xe1, 0x7b, 0x80, 0xb0, 0x26, 0xb0, 0xbe, 0x67, 0x4c, 0x9c,
-	0xd9, 0xb3, 0x69, 0xc3, 0x9e, 0x69, 0x43, 0xaf, 0x01, 0xe5, 0x2f, 0xe0, 0x79, 0x47, 0x41, 0xdd,
-	0xc2, 0x3b, 0xb0, 0x16, 0x14, 0xe6, 0x24, 0x71, 0x0f, 0xcc, 0x8f, 0x74, 0xaf, 0xcb, 0x16, 0x9f,
-	0x38, 0x86, 0xed, 0xcf, 0x41, 0xbc, 0x26, 0x55, 0xcc, 0xf6, 0xcb, 0x80, 0xff, 0xea, 0x83, 0x55,
-	0x0a, 0x15, 0x80, 0x65, 0x24, 0x63, 0xd2, 0xa4, 0x32, 0xd0, 0xee, 0xf5, 0x2b, 0xf7, 0x8a, 0xa6,
-	0xce, 0xbe, 0x06, 0x49, 0x42, 0xb1, 0x60, 0xa6, 0x67, 0x16, 0x4d, 0x55, 0x31, 0x72, 0x18, 0x2e,
-	0xe9, 0x4e, 0x2e, 0x69, 0x95, 0xc5, 0x81, 0x24, 0xb6, 0xa5, 0x58, 0xad, 0x33, 0x3c, 0x00, 0xcb,
-	0xa7, 0x5b, 0xba, 0xcb, 0xd8, 0xb6, 0xca, 0xea, 0x08, 0x3d, 0x70, 0xae, 0xfc, 0xcb, 0x9a, 0x6a,
-	0xa9, 0x64, 0xf3, 0xa8, 0x50, 0x3f, 0x4d, 0xaf, 0xef, 0x6b, 0xc8, 0x4e, 0xa9, 0xde, 0x3c, 0x43,
-	0x06, 0x3b, 0xaa, 0x9b, 0x7c, 0xc5, 0x06, 0x9e, 0x31, 0x19, 0xf8, 0x55, 0x58, 0x64, 0x4e, 0x42,
-	0x19, 0xa5, 0x89, 0x60, 0xb6, 0xba, 0x76, 0x15, 0xe2, 0x5b, 0xd8, 0x29, 0x4d, 0x13, 0x0c, 0x3c,
-	0x73, 0xe2, 0xcc, 0x46, 0xad, 0x77, 0x28, 0x73, 0x7e, 0x85, 0xe1, 0x1f, 0x00, 0x36, 0xce, 0xe3,
-	0x1b, 0xb0, 0xca, 0xc7, 0x61, 0x46, 0x07, 0x57, 0x4f, 0x98, 0x86, 0xf0, 0xc3, 0x8d, 0x77, 0x88,
-	0xb0, 0x15, 0x17, 0x4f, 0x6f, 0xa8, 0xcb, 0xa8, 0x6f, 0x3e, 0x06, 0x2c, 0xa6, 0x5d, 0x43, 0xf4,
-	0x18, 0xcd, 0x7e, 0x9a, 0xb0, 0x5b, 0x0a, 0x2d, 0x28, 0xff, 0x1e, 0x85, 0x84, 0x9f, 0x60, 0xb7,
-	0xb5, 0x15, 0x78, 0xd4, 0xaa, 0xda, 0xb5, 0x31, 0xee, 0x9f, 0x86, 0x8b, 0xf7, 0xf0, 0x04, 0xec,
-	0x9a, 0x82, 0x2f, 0xbb, 0xa5, 0x2a, 0x99, 0xae, 0xfe, 0x78, 0x0f, 0x4f, 0xc1, 0xae, 0xf7, 0x11,
-	0xbb, 0x30, 0xee, 0xe1, 0x03, 0x53, 0x1f, 0x2e, 0x6f, 0x0f, 0xaf, 0x60, 0xd8, 0xdc, 0x4b, 0xf4,
-	0x5a, 0x8c, 0x8e, 0xcd, 0x76, 0x8f, 0xfe, 0x82, 0xa8, 0x65, 0xcf, 0xc1, 0x69, 0x98, 0x8a, 0xaf,
-	0x1e, 0xf5, 0xd7, 0xb6, 0xdb, 0xdd, 0x6f, 0xdf, 0x5e, 0x67, 0x79, 0xef, 0x8b, 0xa5, 0xfe, 0x61,
-	0xc7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x32, 0x48, 0xf5, 0xd3, 0x04, 0x00, 0x00,
+	// 1565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xdb, 0x72, 0xdb, 0x36,
+	0x13, 0xb6, 0x24, 0x9f, 0xb4, 0xb2, 0x1d, 0x1b, 0x76, 0x1c, 0x44, 0x49, 0x1c, 0x85, 0x39, 0x79,
+	0xfe, 0x43, 0xfe, 0x7f, 0x9c, 0x99, 0x76, 0x7a, 0x29, 0x5b, 0xa9, 0xe3, 0xd6, 0x4e, 0x32, 0x94,
+	0xdd, 0xeb, 0x32, 0xd2, 0xda, 0xe6, 0x84, 0x22, 0x59, 0x02, 0x4a, 0xac, 0xde, 0xf7, 0x35, 0x7a,
+	0xd7, 0xe9, 0xf4, 0x2d, 0x7a, 0xd9, 0xc7, 0xea, 0x2c, 0x0e, 0x24, 0x28, 0xca, 0x89, 0x7b, 0x87,
+	0xfd, 0xb0, 0x58, 0x2c, 0x76, 0x3f, 0x2e, 0xb0, 0x84, 0x95, 0x41, 0x12, 0x9f, 0x87, 0x17, 0x2f,
+	0xd2, 0x2c, 0x91, 0x09, 0x6b, 0x9d, 0x87, 0x19, 0x0a, 0x19, 0x64, 0x12, 0x33, 0xcf, 0x83, 0xf5,
+	0x43, 0x94, 0x07, 0x6a, 0xde, 0xc7, 0x9f, 0xc6, 0x28, 0x24, 0x5b, 0x83, 0xfa, 0x51, 0x8f, 0xd7,
+	0x3a, 0xb5, 0xdd, 0xa6, 0x5f, 0x3f, 0xea, 0x79, 0xdb, 0xb0, 0x95, 0xeb, 0x1c, 0x87, 0x42, 0x1a,
+	0x3d, 0x6f, 0x13, 0x36, 0xfa, 0xc5, 0x5a, 0x91, 0x26, 0xb1, 0x40, 0xef, 0x29, 0x6c, 0xf6, 0x30,
+	0x42, 0x89, 0x5f, 0xb4, 0x59, 0x56, 0x33, 0xcb, 0x5f, 0xc2, 0xed, 0xde, 0x78, 0x94, 0x56, 0x36,
+	0x63, 0x6d, 0x58, 0x4e, 0x03, 0x21, 0x3e, 0x25, 0xd9, 0xd0, 0x98, 0xc9, 0x65, 0xef, 0x97, 0x1a,
+	0x70, 0x1f, 0x85, 0x4c, 0x32, 0xfc, 0x47, 0x0b, 0xd9, 0xd7, 0x00, 0x83, 0x7c, 0x01, 0xaf, 0x77,
+	0x6a, 0xbb, 0xad, 0xbd, 0x3b, 0x2f, 0x9c, 0xf8, 0xbc, 0x70, 0xec, 0x39, 0xaa, 0x6c, 0x0b, 0x16,
+	0x46, 0x98, 0x5d, 0x20, 0x6f, 0x74, 0x6a, 0xbb, 0xcb, 0xbe, 0x16, 0xbc, 0x7b, 0x70, 0x77, 0x86,
+	0x1b, 0xe6, 0x64, 0x3f, 0xc2, 0x36, 0xc9, 0xdd, 0xf1, 0x30, 0x94, 0xaf, 0x3e, 0x62, 0x2c, 0x85,
+	0xe3, 0xa1, 0xd6, 0xcf, 0x23, 0x94, 0xcb, 0xb4, 0x51, 0x3f, 0x8c, 0x07, 0xa8, 0x9c, 0x6b, 0xf8,
+	0x5a, 0x20, 0xf4, 0x2c, 0x96, 0x61, 0xa4, 0xb6, 0x6f, 0xf8, 0x5a, 0xf0, 0xfe, 0xac, 0x01, 0x14,
+	0xe6, 0xa7, 0x43, 0xce, 0x18, 0xcc, 0x9f, 0x86, 0x23, 0x6b, 0x49, 0x8d, 0xc9, 0x50, 0x77, 0x20,
+	0x93, 0x4c, 0x19, 0x6a, 0xfa, 0x5a, 0x60, 0xdb, 0xb0, 0xd8, 0x1d, 0xc8, 0x30, 0x89, 0xf9, 0xbc,
+	0x82, 0x8d, 0x54, 0x72, 0x74, 0x61, 0xca, 0x51, 0x06, 0xf3, 0xbd, 0xf0, 0xfc, 0x9c, 0x2f, 0x76,
+	0x1a, 0xbb, 0x4d, 0x5f, 0x8d, 0xc9, 0x4e, 0x0f, 0x65, 0x10, 0x46, 0x7c, 0x49, 0xdb, 0xd1, 0x12,
+	0xe3, 0xb0, 0xf4, 0x76, 0x2c, 0x07, 0xc9, 0x08, 0xf9, 0xb2, 0x9a, 0xb0, 0xa2, 0xd7, 0x85, 0xb5,
+	0xe2, 0x04, 0x2a, 0xd2, 0xff, 0x83, 0x45, 0x25, 0x08, 0x5e, 0xeb, 0x34, 0x2a, 0xe9, 0x29, 0x94,
+	0x7d, 0xa3, 0xe6, 0x45, 0x8a, 0xad, 0xaf, 0xae, 0x70, 0x30, 0x26, 0xa7, 0x6f, 0x14, 0xe5, 0x36,
+	0x2c, 0xbf, 0x0b, 0x2e, 0xb0, 0x1f, 0xfe, 0xac, 0xc3, 0xb3, 0xe0, 0xe7, 0x32, 0xbb, 0x0f, 0x4d,
+	0x1a, 0x9f, 0x26, 0x1f, 0x30, 0x36, 0x61, 0x2a, 0x00, 0xa2, 0xbb, 0xbb, 0xdb, 0x75, 0x74, 0xff,
+	0xa3, 0x0e, 0xcd, 0x5c, 0xe9, 0x46, 0x99, 0xb9, 0x0f, 0xcd, 0x3e, 0x0a, 0x11, 0x26, 0xf1, 0x51,
+	0xcf, 0x6e, 0x9b, 0x03, 0xa5, 0xc3, 0xcc, 0x57, 0x33, 0x71, 0x26, 0x30, 0x33, 0x19, 0x52, 0x63,
+	0x8a, 0xf8, 0xc1, 0x65, 0x10, 0xc7, 0x18, 0xf1, 0x45, 0x1d, 0x71, 0x23, 0x52, 0x8e, 0x4e, 0x50,
+	0x5e, 0x26, 0x43, 0x9b, 0x23, 0x2d, 0xb1, 0x75, 0x68, 0x9c, 0xf9, 0xc7, 0x26, 0x3f, 0x34, 0x24,
+	0xbb, 0xfb, 0xc9, 0x70, 0xc2, 0x9b, 0xda, 0x2e, 0x8d, 0xd9, 0x0e, 0x40, 0x5f, 0x06, 0x72, 0x2c,
+	0x0e, 0x92, 0x21, 0x72, 0x50, 0xa1, 0x73, 0x10, 0x3a, 0xc5, 0x71, 0x20, 0x31, 0x1e, 0x4c, 0x4e,
+	0x04, 0x6f, 0xa9, 0xe3, 0x15, 0x00, 0xb1, 0xef, 0x55, 0x96, 0x25, 0x19, 0x5f, 0xd1, 0xec, 0x53,
+	0x82, 0x37, 0x82, 0xd5, 0x3c, 0x54, 0x8a, 0x02, 0x5f, 0x01, 0xe4, 0x80, 0xa5, 0xc1, 0x76, 0x89,
+	0x06, 0x45, 0xfc, 0x1d, 0x4d, 0xf6, 0x04, 0x56, 0xdf, 0xe0, 0x95, 0x2c, 0xb2, 0x57, 0x57, 0xdb,
+	0x94, 0x41, 0xef, 0xd7, 0x1a, 0x6c, 0x9c, 0xa2, 0x98, 0xaa, 0x81, 0xff, 0x86, 0x45, 0x0d, 0xa8,
+	0x34, 0xb5, 0xf6, 0x36, 0x67, 0x54, 0x05, 0xdf, 0xa8, 0x50, 0x74, 0x4f, 0x50, 0x88, 0xe0, 0x02,
+	0xcd, 0x16, 0x56, 0x74, 0xe3, 0xde, 0x28, 0xc7, 0x7d, 0x0b, 0x16, 0x7e, 0x08, 0xa2, 0x31, 0x9a,
+	0xf4, 0x69, 0x81, 0x62, 0xdc, 0xc7, 0x78, 0xa8, 0x72, 0xb7, 0xec, 0xab, 0xb1, 0xf7, 0x5b, 0x1d,
+	0x98, 0xeb, 0xa0, 0xae, 0x27, 0x6a, 0xd3, 0x40, 0x0e, 0x2e, 0x51, 0x97, 0xb5, 0x65, 0xdf, 0x8a,
+	0x94, 0xd2, 0xc3, 0x2c, 0x19, 0xa7, 0x82, 0xd7, 0xd5, 0xc7, 0x68, 0x24, 0x45, 0x33, 0xbc, 0x92,
+	0xc6, 0x13, 0x35, 0xb6, 0x69, 0x9e, 0xaf, 0xa6, 0x79, 0xc1, 0x49, 0xf3, 0x7f, 0x61, 0xe9, 0x35,
+	0x06, 0x43, 0xcc, 0x84, 0xfa, 0xbe, 0xa7, 0xc3, 0xa1, 0xe7, 0x7c, 0xab, 0x33, 0xc5, 0x8a, 0xa5,
+	0x0a, 0x2b, 0x3c, 0x58, 0xb1, 0xc7, 0x50, 0x5b, 0x69, 0x92, 0x95, 0x30, 0x62, 0xb8, 0x95, 0x0d,
+	0xe3, 0x72, 0xb9, 0xe0, 0x0d, 0xb8, 0xbc, 0xf9, 0x3f, 0x2c, 0xf6, 0x71, 0x90, 0xa1, 0x3a, 0xd4,
+	0xf7, 0x38, 0x31, 0x1f, 0x18, 0x0d, 0x8b, 0x68, 0xd7, 0x9d, 0x68, 0xd3, 0x0a, 0xed, 0xf2, 0x8d,
+	0x57, 0xbc, 0x86, 0xf9, 0xbe, 0xc4, 0x94, 0x82, 0xf4, 0x26, 0x18, 0xa1, 0x59, 0xa0, 0xc6, 0x79,
+	0x78, 0xeb, 0x4e, 0x78, 0xa9, 0xd2, 0xa5, 0x9a, 0xb7, 0x0d, 0x95, 0x0b, 0x2b, 0x7a, 0xbf, 0x83,
+	0x65, 0x18, 0x6d, 0x75, 0x1a, 0xca, 0xc8, 0x5a, 0xd3, 0x82, 0x29, 0x12, 0xf5, 0xbc, 0x48, 0xd0,
+	0x27, 0xaf, 0xb9, 0x63, 0x6d, 0xe5, 0x32, 0x05, 0x94, 0xb6, 0x3b, 0xc5, 0x51, 0x1a, 0x05, 0xd2,
+	0x72, 0xaa, 0x84, 0x11, 0x2b, 0x7c, 0xbc, 0xc0, 0xab, 0xd4, 0x64, 0xd6, 0x48, 0xac, 0x03, 0xad,
+	0x33, 0xff, 0x38, 0x5f, 0xaa, 0xcb, 0x83, 0x0b, 0x91, 0x75, 0x4a, 0x49, 0xae, 0xa2, 0x0b, 0x45,
+	0x09, 0x53, 0x44, 0xa7, 0xd3, 0x64, 0x23, 0x95, 0xcd, 0x65, 0xdf, 0x8a, 0x34, 0xa3, 0xaf, 0x0f,
+	0xc1, 0x9b, 0x3a, 0x04, 0x46, 0x24, 0x56, 0xe9, 0x84, 0x09, 0x0e, 0x33, 0x58, 0xa5, 0xe7, 0x7c,
+	0xab, 0xe3, 0x54, 0xaa, 0x56, 0xa9, 0x52, 0x75, 0xa0, 0x75, 0x90, 0xc4, 0x12, 0x63, 0x79, 0x3a,
+	0x49, 0xd1, 0xd4, 0x12, 0x17, 0x72, 0xe9, 0xbb, 0x7a, 0x03, 0xfa, 0xfe, 0x0b, 0xd6, 0x2d, 0xd5,
+	0xf2, 0x33, 0xaf, 0x29, 0xab, 0x15, 0x5c, 0xeb, 0xca, 0x6c, 0x72, 0x12, 0x5c, 0x75, 0xa5, 0xc4,
+	0x51, 0x2a, 0x05, 0xbf, 0xa5, 0x08, 0x5f, 0xc1, 0x35, 0xed, 0x65, 0x36, 0xd9, 0x0f, 0x06, 0x1f,
+	0x92, 0xf3, 0x73, 0xbe, 0x6e, 0x69, 0x5f, 0x60, 0xb9, 0xbd, 0xe2, 0x6b, 0x11, 0x7c, 0xa3, 0xd3,
+	0xc8, 0xed, 0x39, 0x38, 0x45, 0x96, 0xae, 0x8a, 0x64, 0x2c, 0x39, 0xd3, 0xc5, 0xc5, 0x88, 0x9a,
+	0x8a, 0xc1, 0x88, 0x6f, 0x5a, 0x2a, 0x06, 0x23, 0x9d, 0xa1, 0xd1, 0x28, 0x88, 0x87, 0x7c, 0xcb,
+	0x94, 0x22, 0x2d, 0xea, 0x4f, 0x2d, 0xd0, 0x17, 0xfe, 0x6d, 0xfb, 0xa9, 0x05, 0xf9, 0x95, 0xdf,
+	0xa7, 0xa2, 0x32, 0x8e, 0x90, 0x6f, 0xeb, 0x39, 0x2b, 0xb3, 0xe7, 0xb0, 0x40, 0x1f, 0x83, 0xe0,
+	0x77, 0x54, 0x50, 0x37, 0xca, 0xd9, 0x93, 0x98, 0xfa, 0x7a, 0x9e, 0xea, 0x81, 0xa1, 0x3d, 0xd5,
+	0x1a, 0xae, 0xcc, 0x38, 0x08, 0xdd, 0x12, 0xdd, 0x34, 0xcd, 0x92, 0x8f, 0x94, 0xa1, 0xbb, 0x8a,
+	0x24, 0x05, 0xc0, 0xfe, 0x03, 0x1b, 0x54, 0x95, 0xc3, 0x0c, 0x87, 0x1a, 0x0c, 0x22, 0xc1, 0xdb,
+	0x2a, 0xc6, 0xd5, 0x09, 0x0a, 0x60, 0x0f, 0xe3, 0x49, 0x1f, 0xa3, 0x73, 0x0b, 0xf2, 0x7b, 0x8a,
+	0x91, 0x15, 0x9c, 0x12, 0xd2, 0x8d, 0xa2, 0xe4, 0x13, 0x0e, 0xe9, 0x92, 0x14, 0xfc, 0xbe, 0xda,
+	0xba, 0x84, 0x11, 0xbb, 0x7a, 0x18, 0x87, 0x56, 0xe5, 0x81, 0x52, 0x71, 0x21, 0xf5, 0x78, 0xc8,
+	0xc2, 0x24, 0x0b, 0xe5, 0x84, 0xef, 0x98, 0xc7, 0x83, 0x91, 0xe9, 0x64, 0xaa, 0x2a, 0x9f, 0x50,
+	0x21, 0x7c, 0xa8, 0x6f, 0xf1, 0x1c, 0xa0, 0x0b, 0xca, 0xc7, 0x34, 0x9a, 0x1c, 0xc5, 0xa7, 0x97,
+	0x19, 0x06, 0x43, 0xde, 0x51, 0x8e, 0x96, 0x41, 0xf2, 0x40, 0x8f, 0xd4, 0x42, 0xfe, 0x48, 0xf3,
+	0xdb, 0x81, 0xd4, 0x5b, 0x41, 0xf1, 0x82, 0xc2, 0xeb, 0x99, 0xb7, 0x82, 0x05, 0xd8, 0x33, 0x58,
+	0xd3, 0xc2, 0x51, 0x2c, 0x31, 0xa3, 0x78, 0x3c, 0x56, 0x2a, 0x53, 0x28, 0x79, 0xa3, 0x11, 0x4b,
+	0xaa, 0x27, 0xfa, 0xba, 0x2c, 0x81, 0x85, 0x56, 0x7f, 0x3c, 0x18, 0xa0, 0x10, 0xfc, 0xa9, 0xab,
+	0x65, 0xc0, 0x42, 0xeb, 0xdb, 0x20, 0x8c, 0xc6, 0x19, 0xf2, 0x67, 0xae, 0x96, 0x01, 0x0b, 0xad,
+	0x77, 0x18, 0x0f, 0xc3, 0xf8, 0x82, 0x3f, 0x77, 0xb5, 0x0c, 0xe8, 0x7d, 0x03, 0x50, 0x3c, 0xa7,
+	0xe9, 0x62, 0x1e, 0xd8, 0x8b, 0xb9, 0x71, 0xed, 0xc5, 0xac, 0x55, 0xbc, 0x9d, 0xa2, 0x66, 0xd2,
+	0x37, 0x11, 0xd1, 0x2b, 0xbf, 0xa6, 0x1f, 0xa8, 0x34, 0xf6, 0xb6, 0x80, 0x51, 0x67, 0x63, 0x54,
+	0x6c, 0x5f, 0xb3, 0x4f, 0x85, 0x66, 0xf4, 0x1e, 0xb3, 0x59, 0x0f, 0x35, 0x55, 0xf6, 0xeb, 0x4e,
+	0xd9, 0xdf, 0x82, 0x05, 0x75, 0xbf, 0xda, 0x56, 0x40, 0x09, 0xde, 0x1e, 0x2c, 0x69, 0x1b, 0x82,
+	0x3d, 0x77, 0x36, 0x9e, 0xf6, 0x57, 0xeb, 0x18, 0x6f, 0x36, 0x61, 0xe3, 0x10, 0xa5, 0x59, 0x66,
+	0x9c, 0xd9, 0xfb, 0x6b, 0x09, 0x56, 0xf5, 0xa9, 0xfa, 0x98, 0x7d, 0x0c, 0x07, 0xc8, 0xde, 0xc2,
+	0x5a, 0xb9, 0x45, 0x62, 0x5e, 0xc9, 0xe6, 0xcc, 0xfe, 0xa9, 0x7d, 0x5d, 0x5b, 0xe3, 0xcd, 0xb1,
+	0x21, 0x6c, 0x54, 0xda, 0x16, 0xf6, 0xb4, 0xa4, 0x7f, 0x5d, 0x77, 0xd5, 0x7e, 0xf6, 0x25, 0x35,
+	0xd3, 0xfd, 0xcc, 0xb1, 0x13, 0x58, 0x2d, 0x75, 0x91, 0xec, 0x51, 0x69, 0xe9, 0xac, 0x0e, 0xf3,
+	0x73, 0x4e, 0x77, 0xa1, 0x99, 0x2f, 0x61, 0x0f, 0x66, 0x9b, 0xb2, 0x66, 0x66, 0x71, 0xc4, 0x9b,
+	0x63, 0xfb, 0xf4, 0xc4, 0xb6, 0x26, 0x66, 0xe9, 0xb4, 0x77, 0xa6, 0x2e, 0xa4, 0xe9, 0x66, 0x77,
+	0x8e, 0x9d, 0xc1, 0x8a, 0xdb, 0xc7, 0xb2, 0x4e, 0x39, 0x15, 0xd5, 0x4e, 0xb8, 0xfd, 0xe8, 0x33,
+	0x1a, 0xb9, 0xd9, 0x43, 0x68, 0x39, 0xc4, 0x64, 0x0f, 0x2b, 0xe7, 0x2b, 0x53, 0xb6, 0x7d, 0xbb,
+	0xec, 0xbd, 0x99, 0xf5, 0xe6, 0x58, 0x0f, 0xa0, 0xe0, 0x14, 0xdb, 0x99, 0xb6, 0x53, 0x26, 0x5b,
+	0x7b, 0x6b, 0x06, 0x39, 0x85, 0x3a, 0xe5, 0xad, 0xa9, 0xde, 0x95, 0x3d, 0x2e, 0xa9, 0xce, 0xee,
+	0x6c, 0xdb, 0xf7, 0xae, 0x69, 0xd6, 0x4c, 0x0e, 0xdf, 0x29, 0x4a, 0x38, 0x2f, 0xf6, 0x0a, 0x25,
+	0x2a, 0x6d, 0x5c, 0xbb, 0x3d, 0xfb, 0xe1, 0x6f, 0x2c, 0x7e, 0x07, 0x2b, 0xee, 0xaa, 0xa9, 0x74,
+	0xcc, 0xe8, 0xd4, 0xda, 0xd7, 0x34, 0x12, 0xde, 0x1c, 0x7b, 0x0b, 0x50, 0x3c, 0xbb, 0xa7, 0x42,
+	0x57, 0x69, 0x18, 0xda, 0x0f, 0xaf, 0x9d, 0xb7, 0x49, 0x7d, 0xbf, 0xa8, 0xfe, 0xbf, 0xbc, 0xfc,
+	0x3b, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xe9, 0xdb, 0x1b, 0x8f, 0x11, 0x00, 0x00,
 }